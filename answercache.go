@@ -0,0 +1,135 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// AnswerCache memoizes answers by normalized question, for chatbot workloads
+// where the same questions recur phrased slightly differently. Unlike Cache it
+// ignores search options: "What is Go?" and "what is go" share one answer.
+// Concurrent lookups of the same question wait for a single search.
+// The zero value is ready to use and safe for concurrent use.
+type AnswerCache struct {
+	// TTL bounds how long answers are served. Zero means no expiry.
+	TTL time.Duration
+	// Stem additionally reduces words to a crude stem, so "running costs" and
+	// "run cost" match.
+	Stem bool
+	// Clock decides when answers expire. Nil uses SystemClock.
+	Clock Clock
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+	flights flightGroup[string]
+}
+
+// Get returns the cached answer for question.
+func (c *AnswerCache) Get(question string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.get(NormalizeQuestion(question, c.Stem))
+}
+
+// Set stores the answer for question.
+func (c *AnswerCache) Set(question, answer string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.set(NormalizeQuestion(question, c.Stem), answer)
+}
+
+// Len returns the number of cached answers, including expired ones not yet replaced.
+func (c *AnswerCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// do returns the cached answer for question or computes it with fn, sharing
+// one fn call between concurrent callers. Errors and empty answers are not cached.
+func (c *AnswerCache) do(ctx context.Context, question string, fn func(context.Context) (string, error)) (string, error) {
+	lookup := func() (string, bool) { return c.Get(question) }
+	store := func(answer string) {
+		if answer != "" {
+			c.Set(question, answer)
+		}
+	}
+	answer, _, err := c.flights.do(ctx, NormalizeQuestion(question, c.Stem), lookup, fn, store)
+	return answer, err
+}
+
+func (c *AnswerCache) get(key string) (string, bool) {
+	e, ok := c.entries[key]
+	if !ok || (!e.expires.IsZero() && clockOr(c.Clock).Now().After(e.expires)) {
+		return "", false
+	}
+	return string(e.value), true
+}
+
+func (c *AnswerCache) set(key, answer string) {
+	if c.entries == nil {
+		c.entries = make(map[string]cacheEntry)
+	}
+	e := cacheEntry{value: []byte(answer)}
+	if c.TTL > 0 {
+		e.expires = clockOr(c.Clock).Now().Add(c.TTL)
+	}
+	c.entries[key] = e
+}
+
+// NormalizeQuestion lowercases question, drops punctuation and collapses
+// whitespace. With stem, common English suffixes are stripped from each word.
+func NormalizeQuestion(question string, stem bool) string {
+	words := strings.FieldsFunc(strings.ToLower(question), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	if stem {
+		for i, w := range words {
+			words[i] = stemWord(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// stemSuffixes are tried longest first; a stem keeps at least three letters.
+var stemSuffixes = []string{"ations", "ation", "ings", "ing", "ies", "ed", "es", "s"}
+
+func stemWord(w string) string {
+	for _, suffix := range stemSuffixes {
+		if stem, ok := strings.CutSuffix(w, suffix); ok && len(stem) >= 3 {
+			if suffix == "ies" {
+				return stem + "y"
+			}
+			// "running" -> "runn" -> "run"
+			if n := len(stem); n >= 2 && stem[n-1] == stem[n-2] && suffix == "ing" {
+				stem = stem[:n-1]
+			}
+			return stem
+		}
+	}
+	return w
+}
+
+// Answer returns the AI-generated answer to question, served from
+// Options.AnswerCache when the same question was answered before.
+func (c *Client) Answer(ctx context.Context, question string) (string, error) {
+	search := func(ctx context.Context) (string, error) {
+		resp, err := c.Search(ctx, question, &SearchOptions{IncludeAnswer: true})
+		if err != nil {
+			return "", err
+		}
+		if resp.Answer == "" {
+			return "", fmt.Errorf("no answer returned for %q", question)
+		}
+		return resp.Answer, nil
+	}
+
+	if c.answers == nil {
+		return search(ctx)
+	}
+	return c.answers.do(ctx, question, search)
+}
@@ -0,0 +1,146 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNormalizeQuestion(t *testing.T) {
+	tests := []struct {
+		question string
+		stem     bool
+		want     string
+	}{
+		{question: "  What is Go?! ", want: "what is go"},
+		{question: "What's the running-costs of Tavily?", want: "what s the running costs of tavily"},
+		{question: "What's the running-costs of Tavily?", stem: true, want: "what s the run cost of tavily"},
+		{question: "Which libraries parsed it", stem: true, want: "which library pars it"},
+	}
+	for _, tt := range tests {
+		if got := NormalizeQuestion(tt.question, tt.stem); got != tt.want {
+			t.Errorf("NormalizeQuestion(%q, %v) = %q, want %q", tt.question, tt.stem, got, tt.want)
+		}
+	}
+}
+
+func TestAnswerCache(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "answer": "Go is a programming language.", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:     server.URL,
+		AnswerCache: &AnswerCache{},
+	})
+	ctx := context.Background()
+
+	questions := []string{"What is Go?", "what is go", "WHAT IS GO!!"}
+	var wg sync.WaitGroup
+	answers := make([]string, len(questions))
+	for i, q := range questions {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			answer, err := client.Answer(ctx, q)
+			if err != nil {
+				t.Errorf("Answer(%q) error = %v", q, err)
+			}
+			answers[i] = answer
+		}()
+	}
+	for calls.Load() == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server calls = %d, want 1", got)
+	}
+	for i, answer := range answers {
+		if answer != "Go is a programming language." {
+			t.Errorf("Answer(%q) = %q, want cached answer", questions[i], answer)
+		}
+	}
+
+	if _, err := client.Answer(ctx, "What is Go"); err != nil || calls.Load() != 1 {
+		t.Errorf("Answer() after caching made %d calls, error = %v, want 1 call", calls.Load(), err)
+	}
+}
+
+func TestAnswerCacheSharedCalls(t *testing.T) {
+	t.Run("waiter stops with its own context", func(t *testing.T) {
+		cache := &AnswerCache{}
+		started, release := make(chan struct{}), make(chan struct{})
+		go cache.do(context.Background(), "q", func(context.Context) (string, error) {
+			close(started)
+			<-release
+			return "a", nil
+		})
+		<-started
+		defer close(release)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		if _, err := cache.do(ctx, "q", func(context.Context) (string, error) { return "b", nil }); !errors.Is(err, context.Canceled) {
+			t.Errorf("do() error = %v, want %v", err, context.Canceled)
+		}
+	})
+
+	t.Run("cancelled leader is not shared", func(t *testing.T) {
+		cache := &AnswerCache{}
+		started, release := make(chan struct{}), make(chan struct{})
+		leader := make(chan error, 1)
+		go func() {
+			_, err := cache.do(context.Background(), "q", func(context.Context) (string, error) {
+				close(started)
+				<-release
+				return "", context.Canceled
+			})
+			leader <- err
+		}()
+		<-started
+
+		waiter := make(chan string, 1)
+		go func() {
+			answer, _ := cache.do(context.Background(), "q", func(context.Context) (string, error) { return "fresh", nil })
+			waiter <- answer
+		}()
+		// Give the waiter time to join the call; it must search again either way.
+		time.Sleep(20 * time.Millisecond)
+		close(release)
+
+		if err := <-leader; !errors.Is(err, context.Canceled) {
+			t.Errorf("leader error = %v, want %v", err, context.Canceled)
+		}
+		if got := <-waiter; got != "fresh" {
+			t.Errorf("waiter answer = %q, want it to search again", got)
+		}
+	})
+
+	t.Run("panicking leader releases the key", func(t *testing.T) {
+		cache := &AnswerCache{}
+		func() {
+			defer func() { recover() }()
+			cache.do(context.Background(), "q", func(context.Context) (string, error) { panic("search exploded") })
+		}()
+		answer, err := cache.do(context.Background(), "q", func(context.Context) (string, error) { return "a", nil })
+		if err != nil || answer != "a" {
+			t.Errorf("do() after panic = %q, %v, want a", answer, err)
+		}
+	})
+}
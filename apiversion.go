@@ -0,0 +1,87 @@
+package tavily
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// APIVersion describes one revision of the Tavily API: where its endpoints
+// live, how request fields were renamed and which features it lacks. Set
+// Options.APIVersion to target a revision other than APIVersion1 without
+// waiting for a release of this package. The zero APIVersion is APIVersion1.
+type APIVersion struct {
+	Name string
+	// PathPrefix is prepended to every endpoint path, e.g. "/v2".
+	PathPrefix string
+	// RenameFields maps request JSON fields to their name in this version,
+	// e.g. {"max_results": "limit"}.
+	RenameFields map[string]string
+	// Unsupported lists features calls fail fast on with a *VersionError.
+	Unsupported []Feature
+}
+
+// APIVersion1 is the current, unprefixed Tavily API.
+var APIVersion1 = APIVersion{Name: "v1"}
+
+// KnownAPIVersions are the versions a VersionError consults to say where a
+// feature is available. Append versions you declare so errors point to them.
+var KnownAPIVersions = []APIVersion{APIVersion1}
+
+// Supports reports whether the version includes feature.
+func (v APIVersion) Supports(feature Feature) bool {
+	return !slices.Contains(v.Unsupported, feature)
+}
+
+// VersionError reports a call needing a feature the configured API version lacks.
+type VersionError struct {
+	Version string
+	Feature Feature
+	// SupportedIn names the KnownAPIVersions that include the feature.
+	SupportedIn []string
+}
+
+func (e *VersionError) Error() string {
+	msg := fmt.Sprintf("%s is not available in Tavily API %s", e.Feature, e.Version)
+	if len(e.SupportedIn) == 0 {
+		return msg
+	}
+	return msg + " (supported in " + strings.Join(e.SupportedIn, ", ") + ")"
+}
+
+// check rejects requests that need a feature v does not support.
+func (v APIVersion) check(endpoint string, request any) error {
+	for _, f := range requiredFeatures(endpoint, request) {
+		if v.Supports(f) {
+			continue
+		}
+		err := &VersionError{Version: v.Name, Feature: f}
+		for _, known := range KnownAPIVersions {
+			if known.Name != v.Name && known.Supports(f) {
+				err.SupportedIn = append(err.SupportedIn, known.Name)
+			}
+		}
+		return err
+	}
+	return nil
+}
+
+// encode renames top-level fields of the JSON request body per RenameFields.
+func (v APIVersion) encode(body []byte) ([]byte, error) {
+	if len(v.RenameFields) == 0 || len(body) == 0 {
+		return body, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil || fields == nil {
+		// Not an object; nothing to rename.
+		return body, nil
+	}
+	for from, to := range v.RenameFields {
+		if value, ok := fields[from]; ok {
+			delete(fields, from)
+			fields[to] = value
+		}
+	}
+	return json.Marshal(fields)
+}
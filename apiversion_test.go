@@ -0,0 +1,64 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestAPIVersionRouting(t *testing.T) {
+	var gotPath string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	v2 := APIVersion{
+		Name:         "v2",
+		PathPrefix:   "/v2",
+		RenameFields: map[string]string{"max_results": "limit"},
+		Unsupported:  []Feature{FeatureCountry},
+	}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, APIVersion: v2})
+
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if gotPath != "/v2/search" {
+		t.Errorf("path = %v, want %v", gotPath, "/v2/search")
+	}
+	if _, ok := body["max_results"]; ok || body["limit"] != 5.0 {
+		t.Errorf("body = %v, want max_results renamed to limit", body)
+	}
+
+	gotPath = ""
+	_, err := client.Search(context.Background(), "q", &SearchOptions{Country: string(CountryGermany)})
+	var verr *VersionError
+	if !errors.As(err, &verr) {
+		t.Fatalf("Search() error = %v, want *VersionError", err)
+	}
+	if verr.Feature != FeatureCountry || verr.Version != "v2" || !slices.Equal(verr.SupportedIn, []string{"v1"}) {
+		t.Errorf("VersionError = %+v, want country supported in v1", verr)
+	}
+	if want := "country is not available in Tavily API v2 (supported in v1)"; verr.Error() != want {
+		t.Errorf("Error() = %q, want %q", verr.Error(), want)
+	}
+	if gotPath != "" {
+		t.Error("Expected the unsupported request not to be sent")
+	}
+}
+
+func TestAPIVersionDefault(t *testing.T) {
+	client := New("tvly-test-key", nil)
+	if client.version.Name != "v1" || client.version.PathPrefix != "" {
+		t.Errorf("default version = %+v, want APIVersion1", client.version)
+	}
+}
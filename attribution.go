@@ -0,0 +1,75 @@
+package tavily
+
+import (
+	"strings"
+	"time"
+)
+
+// Attribution records where an exported document came from, so reuse of
+// retrieved content keeps the provenance content policies require.
+type Attribution struct {
+	Domain      string    `json:"domain"`
+	URL         string    `json:"url"`
+	RetrievedAt time.Time `json:"retrieved_at"`
+	RequestID   string    `json:"request_id,omitempty"`
+	// License is the policy's license or terms for the domain, if any.
+	License string `json:"license,omitempty"`
+}
+
+// String renders the attribution as one line, e.g. "Source: example.com,
+// https://example.com/a, retrieved 2025-01-02T15:04:05Z, license CC-BY-4.0".
+func (a Attribution) String() string {
+	parts := []string{"Source: " + a.Domain, a.URL}
+	if !a.RetrievedAt.IsZero() {
+		parts = append(parts, "retrieved "+a.RetrievedAt.UTC().Format(time.RFC3339))
+	}
+	if a.License != "" {
+		parts = append(parts, "license "+a.License)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// AttributionPolicy makes exporters attach an Attribution to every document.
+type AttributionPolicy struct {
+	// Licenses maps domains to the license or terms their content is used
+	// under. Entries match subdomains too.
+	Licenses map[string]string `json:"licenses,omitempty" yaml:"licenses,omitempty"`
+	// DefaultLicense applies to domains without an entry in Licenses.
+	DefaultLicense string `json:"default_license,omitempty" yaml:"default_license,omitempty"`
+}
+
+// Attribute describes the document at url retrieved at retrievedAt by the
+// request with requestID.
+func (p *AttributionPolicy) Attribute(url string, retrievedAt time.Time, requestID string) Attribution {
+	domain := strings.TrimPrefix(domainOf(url), "www.")
+	return Attribution{
+		Domain:      domain,
+		URL:         url,
+		RetrievedAt: retrievedAt,
+		RequestID:   requestID,
+		License:     p.license(domain),
+	}
+}
+
+// license returns the most specific Licenses entry matching domain.
+func (p *AttributionPolicy) license(domain string) string {
+	for d := domain; d != ""; {
+		if license, ok := p.Licenses[d]; ok {
+			return license
+		}
+		_, parent, found := strings.Cut(d, ".")
+		if !found {
+			break
+		}
+		d = parent
+	}
+	return p.DefaultLicense
+}
+
+// searchAttribution attributes a search result, preferring its provenance.
+func (p *AttributionPolicy) searchAttribution(r SearchResult, meta ResponseMeta) Attribution {
+	if r.Provenance != nil {
+		return p.Attribute(r.URL, r.Provenance.RetrievedAt, r.Provenance.RequestID)
+	}
+	return p.Attribute(r.URL, meta.RetrievedAt, meta.RequestID)
+}
@@ -0,0 +1,109 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestAttributionPolicy(t *testing.T) {
+	policy := &AttributionPolicy{
+		Licenses: map[string]string{
+			"wikipedia.org":    "CC BY-SA 4.0",
+			"en.wikipedia.org": "CC BY-SA 4.0 (en)",
+		},
+		DefaultLicense: "all rights reserved",
+	}
+	at := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	tests := []struct {
+		url         string
+		wantDomain  string
+		wantLicense string
+	}{
+		{"https://en.wikipedia.org/wiki/Go", "en.wikipedia.org", "CC BY-SA 4.0 (en)"},
+		{"https://de.wikipedia.org/wiki/Go", "de.wikipedia.org", "CC BY-SA 4.0"},
+		{"https://www.example.com/a", "example.com", "all rights reserved"},
+	}
+
+	for _, tt := range tests {
+		got := policy.Attribute(tt.url, at, "req-1")
+		if got.Domain != tt.wantDomain || got.License != tt.wantLicense || got.URL != tt.url {
+			t.Errorf("Attribute(%q) = %+v, want domain %v and license %v", tt.url, got, tt.wantDomain, tt.wantLicense)
+		}
+	}
+
+	want := "Source: example.com, https://www.example.com/a, retrieved 2025-01-02T15:04:05Z, license all rights reserved"
+	if got := policy.Attribute("https://www.example.com/a", at, "").String(); got != want {
+		t.Errorf("Attribution.String() = %q, want %q", got, want)
+	}
+}
+
+func TestExtractToWriterAttribution(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [{"url": "https://example.com/a", "raw_content": "Body."}]}`))
+	}))
+	defer server.Close()
+
+	at := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: tavilytest.NewFakeClock(at)})
+	opts := &ExtractOptions{Attribution: &AttributionPolicy{DefaultLicense: "CC0"}}
+	ctx := WithRequestID(context.Background(), "req-1")
+
+	var jsonl strings.Builder
+	if _, err := client.ExtractToWriter(ctx, []string{"https://example.com/a"}, opts, &jsonl, StreamJSONL); err != nil {
+		t.Fatalf("ExtractToWriter() error = %v", err)
+	}
+	var doc struct {
+		URL         string      `json:"url"`
+		Attribution Attribution `json:"attribution"`
+	}
+	if err := json.Unmarshal([]byte(jsonl.String()), &doc); err != nil {
+		t.Fatalf("ExtractToWriter() wrote invalid JSON: %v", err)
+	}
+	want := Attribution{Domain: "example.com", URL: "https://example.com/a", RetrievedAt: at, RequestID: "req-1", License: "CC0"}
+	if doc.URL != "https://example.com/a" || doc.Attribution != want {
+		t.Errorf("ExtractToWriter() attribution = %+v, want %+v", doc.Attribution, want)
+	}
+
+	var md strings.Builder
+	if _, err := client.ExtractToWriter(ctx, []string{"https://example.com/a"}, opts, &md, StreamMarkdown); err != nil {
+		t.Fatalf("ExtractToWriter() error = %v", err)
+	}
+	if wantMD := "# https://example.com/a\n\n> " + want.String() + "\n\nBody.\n"; md.String() != wantMD {
+		t.Errorf("ExtractToWriter() markdown = %q, want %q", md.String(), wantMD)
+	}
+}
+
+func TestRenderHTMLAttribution(t *testing.T) {
+	at := time.Date(2025, 1, 2, 15, 4, 5, 0, time.UTC)
+	opts := &ReportOptions{Attribution: &AttributionPolicy{DefaultLicense: "CC0"}}
+
+	search := &SearchResponse{Results: []SearchResult{{
+		URL:        "https://example.com/a",
+		Provenance: &Provenance{RequestID: "req-1", RetrievedAt: at},
+	}}}
+	var b strings.Builder
+	if err := RenderSearchHTML(&b, search, opts); err != nil {
+		t.Fatalf("RenderSearchHTML() error = %v", err)
+	}
+	if !strings.Contains(b.String(), "retrieved 2025-01-02T15:04:05Z, license CC0") {
+		t.Errorf("RenderSearchHTML() has no attribution:\n%s", b.String())
+	}
+
+	crawl := &CrawlResponse{Results: []CrawlResult{{URL: "https://example.com/b"}}, Meta: ResponseMeta{RetrievedAt: at}}
+	b.Reset()
+	if err := RenderCrawlHTML(&b, crawl, opts); err != nil {
+		t.Fatalf("RenderCrawlHTML() error = %v", err)
+	}
+	if !strings.Contains(b.String(), "Source: example.com, https://example.com/b") {
+		t.Errorf("RenderCrawlHTML() has no attribution:\n%s", b.String())
+	}
+}
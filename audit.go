@@ -0,0 +1,147 @@
+package tavily
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+)
+
+type actorKey struct{}
+
+// WithActor returns a context whose Tavily calls are attributed to actor, such
+// as a user or service account, in the audit log.
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFromContext returns the actor stored by WithActor.
+func ActorFromContext(ctx context.Context) (string, bool) {
+	actor, ok := ctx.Value(actorKey{}).(string)
+	return actor, ok && actor != ""
+}
+
+// AuditRecord describes one retrieval of external data: a request sent to the
+// API or answered from the cache, or a page the client fetched directly, such
+// as a link check, robots.txt or a local extraction fallback.
+type AuditRecord struct {
+	Time time.Time `json:"time"`
+	// RequestID, Tenant and Actor identify the call and who initiated it, as
+	// set with WithRequestID, WithTenant and WithActor.
+	RequestID string `json:"request_id"`
+	Tenant    string `json:"tenant,omitempty"`
+	Actor     string `json:"actor,omitempty"`
+	Method    string `json:"method"`
+	// Endpoint is the API endpoint called, or "" for a direct fetch.
+	Endpoint string `json:"endpoint"`
+	// Query is the search query, for searches.
+	Query string `json:"query,omitempty"`
+	// URLs lists the pages requested from extract, crawl and map, or the
+	// page fetched directly.
+	URLs []string `json:"urls,omitempty"`
+	// StatusCode is the HTTP status of a direct fetch.
+	StatusCode int `json:"status_code,omitempty"`
+	// Request is the request body as sent.
+	Request  json.RawMessage `json:"request,omitempty"`
+	CacheHit bool            `json:"cache_hit"`
+	Attempts int             `json:"attempts"`
+	// Digest is the SHA-256 of the response body, as "sha256:<hex>", so the
+	// data returned can later be proven. It is empty when the call failed and
+	// for direct fetches, whose bodies are read as they are used.
+	Digest string `json:"digest,omitempty"`
+	// Error is set when the call failed.
+	Error string `json:"error,omitempty"`
+}
+
+// AuditSink stores audit records. It should only ever append, and must be
+// safe for concurrent use.
+type AuditSink interface {
+	WriteAudit(ctx context.Context, record AuditRecord) error
+}
+
+// AuditSinkFunc adapts a function to AuditSink.
+type AuditSinkFunc func(ctx context.Context, record AuditRecord) error
+
+// WriteAudit implements AuditSink.
+func (f AuditSinkFunc) WriteAudit(ctx context.Context, record AuditRecord) error {
+	return f(ctx, record)
+}
+
+// JSONLinesAuditSink writes each record as one line of JSON, e.g. to a file
+// opened with os.O_APPEND.
+type JSONLinesAuditSink struct {
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// NewJSONLinesAuditSink creates a JSONLinesAuditSink writing to w.
+func NewJSONLinesAuditSink(w io.Writer) *JSONLinesAuditSink {
+	return &JSONLinesAuditSink{w: w}
+}
+
+// WriteAudit implements AuditSink.
+func (s *JSONLinesAuditSink) WriteAudit(_ context.Context, record AuditRecord) error {
+	line, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(line, '\n'))
+	return err
+}
+
+// newAuditRecord describes a call to endpoint before its outcome is known.
+func (c *Client) newAuditRecord(ctx context.Context, method, endpoint, requestID string, request any, body []byte) AuditRecord {
+	record := AuditRecord{
+		Time:      c.clock.Now(),
+		RequestID: requestID,
+		Method:    method,
+		Endpoint:  endpoint,
+		Request:   json.RawMessage(body),
+	}
+	record.Tenant, _ = TenantFromContext(ctx)
+	record.Actor, _ = ActorFromContext(ctx)
+	switch req := request.(type) {
+	case *SearchRequest:
+		record.Query = req.Query
+	case *ExtractRequest:
+		record.URLs = slices.Clone(req.URLs)
+	case *CrawlRequest:
+		record.URLs = []string{req.URL}
+	case *MapRequest:
+		record.URLs = []string{req.URL}
+	}
+	return record
+}
+
+// audit completes record with the outcome of the call and writes it to the
+// client's AuditSink. A record that cannot be written fails the call, so no
+// retrieved data is used unlogged; under PanicContinue a panicking sink is
+// skipped.
+func (c *Client) audit(ctx context.Context, record AuditRecord, respData []byte, callErr error) error {
+	if callErr != nil {
+		record.Error = c.redactor.Redact(callErr.Error())
+	} else if respData != nil {
+		sum := sha256.Sum256(respData)
+		record.Digest = "sha256:" + hex.EncodeToString(sum[:])
+	}
+
+	var err error
+	if perr := c.runHook(ctx, "AuditSink", func() { err = c.auditSink.WriteAudit(ctx, record) }); perr != nil {
+		if !c.abortOnPanic() {
+			return callErr
+		}
+		err = perr
+	}
+	if err != nil {
+		return errors.Join(callErr, fmt.Errorf("failed to write audit record: %w", err))
+	}
+	return callErr
+}
@@ -0,0 +1,159 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type recordingAuditSink struct {
+	mu      sync.Mutex
+	records []AuditRecord
+}
+
+func (s *recordingAuditSink) WriteAudit(_ context.Context, record AuditRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, record)
+	return nil
+}
+
+func TestAuditSink(t *testing.T) {
+	const body = `{"query": "q", "results": []}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/extract" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail": {"error": "bad url"}}`))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	sink := &recordingAuditSink{}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, AuditSink: sink, Cache: &MemoryCache{}})
+	ctx := WithActor(WithTenant(WithRequestID(context.Background(), "req-1"), "acme"), "alice")
+
+	for range 2 {
+		if _, err := client.Search(ctx, "earnings", nil); err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+	}
+	if _, err := client.Extract(ctx, []string{"https://a.example/"}, nil); err == nil {
+		t.Fatalf("Extract() error = nil, want an error")
+	}
+
+	if len(sink.records) != 3 {
+		t.Fatalf("audit records = %d, want 3", len(sink.records))
+	}
+	sum := sha256.Sum256([]byte(body))
+	first := sink.records[0]
+	if first.RequestID != "req-1" || first.Tenant != "acme" || first.Actor != "alice" {
+		t.Errorf("audit record initiator = %v/%v/%v, want req-1/acme/alice", first.RequestID, first.Tenant, first.Actor)
+	}
+	if first.Endpoint != "/search" || first.Method != http.MethodPost || first.Query != "earnings" {
+		t.Errorf("audit record call = %v %v %q", first.Method, first.Endpoint, first.Query)
+	}
+	if want := "sha256:" + hex.EncodeToString(sum[:]); first.Digest != want {
+		t.Errorf("audit record digest = %v, want %v", first.Digest, want)
+	}
+	if !strings.Contains(string(first.Request), `"query":"earnings"`) || first.CacheHit || first.Attempts != 1 {
+		t.Errorf("audit record = %+v", first)
+	}
+	if second := sink.records[1]; !second.CacheHit || second.Digest != first.Digest {
+		t.Errorf("cached audit record = %+v, want a cache hit with the same digest", second)
+	}
+	failed := sink.records[2]
+	if !slices.Equal(failed.URLs, []string{"https://a.example/"}) || failed.Error == "" || failed.Digest != "" {
+		t.Errorf("failed audit record = %+v", failed)
+	}
+}
+
+func TestAuditSinkFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	errSink := errors.New("disk full")
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		AuditSink: AuditSinkFunc(func(context.Context, AuditRecord) error {
+			return errSink
+		}),
+	})
+	if _, err := client.Search(context.Background(), "q", nil); !errors.Is(err, errSink) {
+		t.Errorf("Search() error = %v, want %v", err, errSink)
+	}
+}
+
+func TestAuditSinkDirectFetches(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			w.Write([]byte("User-agent: *\nAllow: /\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer site.Close()
+
+	sink := &recordingAuditSink{}
+	client := New("tvly-test-key", &Options{AuditSink: sink})
+	ctx := WithActor(context.Background(), "alice")
+
+	if _, err := client.ResolveURL(ctx, site.URL+"/page"); err != nil {
+		t.Fatalf("ResolveURL() error = %v", err)
+	}
+	if _, err := client.Preflight(ctx, site.URL+"/docs"); err != nil {
+		t.Fatalf("Preflight() error = %v", err)
+	}
+
+	type fetch struct{ method, url string }
+	var got []fetch
+	for _, r := range sink.records {
+		if r.Endpoint != "" || r.Actor != "alice" || r.StatusCode != http.StatusOK || r.Digest != "" || len(r.URLs) != 1 {
+			t.Errorf("audit record = %+v, want a direct fetch by alice", r)
+			continue
+		}
+		got = append(got, fetch{r.Method, strings.TrimPrefix(r.URLs[0], site.URL)})
+	}
+	if !slices.Contains(got, fetch{http.MethodHead, "/page"}) || !slices.Contains(got, fetch{http.MethodGet, "/robots.txt"}) {
+		t.Errorf("audited fetches = %v, want the HEAD of /page and robots.txt", got)
+	}
+
+	errSink := errors.New("disk full")
+	failing := New("tvly-test-key", &Options{AuditSink: AuditSinkFunc(func(context.Context, AuditRecord) error { return errSink })})
+	if _, err := failing.ResolveURL(ctx, site.URL+"/page"); !errors.Is(err, errSink) {
+		t.Errorf("ResolveURL() error = %v, want %v", err, errSink)
+	}
+}
+
+func TestJSONLinesAuditSink(t *testing.T) {
+	var buf bytes.Buffer
+	sink := NewJSONLinesAuditSink(&buf)
+	for _, q := range []string{"a", "b"} {
+		if err := sink.WriteAudit(context.Background(), AuditRecord{Query: q}); err != nil {
+			t.Fatalf("WriteAudit() error = %v", err)
+		}
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("WriteAudit() wrote %d lines, want 2", len(lines))
+	}
+	var record AuditRecord
+	if err := json.Unmarshal([]byte(lines[1]), &record); err != nil || record.Query != "b" {
+		t.Errorf("WriteAudit() line = %s, want the second record", lines[1])
+	}
+}
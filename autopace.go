@@ -0,0 +1,120 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Defaults for AutoPace fields left at zero.
+const (
+	DefaultAutoPaceInitialRate = 60
+	DefaultAutoPaceMinRate     = 1
+	DefaultAutoPaceMaxRate     = 1000
+	DefaultAutoPaceIncrease    = 1
+	DefaultAutoPaceDecrease    = 0.5
+)
+
+// AutoPace adapts the outgoing request rate to server feedback, so unattended
+// batch jobs converge on the highest rate the API accepts: the rate grows by
+// Increase after every successful response and is multiplied by Decrease
+// after every 429 (additive increase, multiplicative decrease). When rate
+// limit headers report few remaining requests, the rate is also capped to
+// spread them over the time left until the window resets.
+// Rates are in requests per minute.
+type AutoPace struct {
+	InitialRate float64
+	MinRate     float64
+	MaxRate     float64
+	Increase    float64
+	Decrease    float64
+}
+
+// autoPacer spaces requests at an adaptive rate.
+type autoPacer struct {
+	opts  AutoPace
+	clock Clock
+	mu    sync.Mutex
+	rate  float64
+	next  time.Time
+}
+
+func newAutoPacer(opts *AutoPace, clock Clock) *autoPacer {
+	if opts == nil {
+		return nil
+	}
+	p := &autoPacer{opts: *opts, clock: clockOr(clock)}
+	p.opts.MinRate = defaultFloat(p.opts.MinRate, DefaultAutoPaceMinRate)
+	p.opts.MaxRate = defaultFloat(p.opts.MaxRate, DefaultAutoPaceMaxRate)
+	p.opts.Increase = defaultFloat(p.opts.Increase, DefaultAutoPaceIncrease)
+	p.opts.Decrease = defaultFloat(p.opts.Decrease, DefaultAutoPaceDecrease)
+	p.rate = p.clamp(defaultFloat(p.opts.InitialRate, DefaultAutoPaceInitialRate))
+	return p
+}
+
+func (p *autoPacer) clamp(rate float64) float64 {
+	return min(max(rate, p.opts.MinRate), p.opts.MaxRate)
+}
+
+// wait blocks until the next request may start at the current rate.
+func (p *autoPacer) wait(ctx context.Context) error {
+	if p == nil {
+		return ctx.Err()
+	}
+
+	p.mu.Lock()
+	now := p.clock.Now()
+	start := p.next
+	if start.Before(now) {
+		start = now
+	}
+	p.next = start.Add(time.Duration(float64(time.Minute) / p.rate))
+	p.mu.Unlock()
+
+	return p.clock.Sleep(ctx, start.Sub(now))
+}
+
+// observe adjusts the rate after a response. Transport errors and other
+// statuses leave it unchanged.
+func (p *autoPacer) observe(resp *http.Response, state RateLimitState) {
+	if p == nil || resp == nil {
+		return
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.clock.Now()
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		p.rate = p.clamp(p.rate * p.opts.Decrease)
+		// Start the slower pace now rather than after already reserved slots.
+		p.next = now.Add(time.Duration(float64(time.Minute) / p.rate))
+		if delay, ok := retryAfter(resp, now); ok {
+			p.next = now.Add(delay)
+		}
+	case resp.StatusCode < 300:
+		p.rate = p.clamp(p.rate + p.opts.Increase)
+	}
+
+	if state.Known() && !state.Reset.IsZero() {
+		if left := state.Reset.Sub(now); left > 0 {
+			p.rate = p.clamp(min(p.rate, float64(state.Remaining)/left.Minutes()))
+		}
+	}
+}
+
+// currentRate returns the pacing rate in requests per minute.
+func (p *autoPacer) currentRate() float64 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.rate
+}
+
+func defaultFloat(value, defaultValue float64) float64 {
+	if value == 0 {
+		return defaultValue
+	}
+	return value
+}
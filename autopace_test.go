@@ -0,0 +1,73 @@
+package tavily
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestAutoPacerAIMD(t *testing.T) {
+	p := newAutoPacer(&AutoPace{InitialRate: 100, MinRate: 10, MaxRate: 102}, nil)
+	ok := &http.Response{StatusCode: http.StatusOK, Header: http.Header{}}
+	limited := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{}}
+
+	steps := []struct {
+		resp *http.Response
+		want float64
+	}{
+		{ok, 101},
+		{ok, 102},
+		{ok, 102}, // capped at MaxRate
+		{limited, 51},
+		{limited, 25.5},
+		{limited, 12.75},
+		{limited, 10}, // floored at MinRate
+		{&http.Response{StatusCode: http.StatusInternalServerError, Header: http.Header{}}, 10},
+	}
+	for i, step := range steps {
+		p.observe(step.resp, RateLimitState{})
+		if got := p.currentRate(); math.Abs(got-step.want) > 1e-9 {
+			t.Errorf("step %d: rate = %v, want %v", i, got, step.want)
+		}
+	}
+}
+
+func TestAutoPacerHeaderCap(t *testing.T) {
+	p := newAutoPacer(&AutoPace{InitialRate: 600}, nil)
+	state := RateLimitState{Limit: 100, Remaining: 10, Reset: time.Now().Add(time.Minute), UpdatedAt: time.Now()}
+
+	p.observe(&http.Response{StatusCode: http.StatusOK, Header: http.Header{}}, state)
+	if got := p.currentRate(); got > 10.1 {
+		t.Errorf("rate = %v, want at most 10 requests per minute for 10 remaining over a minute", got)
+	}
+}
+
+func TestAutoPaceRecoversFrom429(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) == 1 {
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:  server.URL,
+		AutoPace: &AutoPace{InitialRate: 12000, MaxRate: 12000},
+		Retry:    &RetryPolicy{BaseDelay: time.Millisecond},
+	})
+
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := client.pacer.currentRate(); got != 6001 {
+		t.Errorf("rate = %v, want %v after one 429 and one success", got, 6001)
+	}
+}
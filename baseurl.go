@@ -0,0 +1,24 @@
+package tavily
+
+import (
+	"context"
+	"strings"
+)
+
+type baseURLKey struct{}
+
+// WithBaseURL returns a context whose Tavily calls are sent to baseURL instead of
+// the client's Options.BaseURL, e.g. to route some traffic through a regional
+// proxy or a mock server while sharing one client.
+func WithBaseURL(ctx context.Context, baseURL string) context.Context {
+	return context.WithValue(ctx, baseURLKey{}, strings.TrimSuffix(baseURL, "/"))
+}
+
+// baseURLFor returns the base URL for a call made with ctx: the override set by
+// WithBaseURL, or else the first healthy configured endpoint.
+func (c *Client) baseURLFor(ctx context.Context) string {
+	if u, ok := ctx.Value(baseURLKey{}).(string); ok && u != "" {
+		return u
+	}
+	return c.endpoints.pick()
+}
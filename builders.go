@@ -0,0 +1,288 @@
+package tavily
+
+import (
+	"context"
+	"time"
+)
+
+// SearchBuilder builds a search call fluently, as an alternative to filling
+// SearchOptions by hand:
+//
+//	resp, err := tavily.NewSearch("golang generics").
+//		Depth(tavily.SearchDepthAdvanced).
+//		Topic(tavily.TopicNews).
+//		MaxResults(10).
+//		Do(ctx, client)
+type SearchBuilder struct {
+	query string
+	opts  SearchOptions
+}
+
+// NewSearch starts building a search for query.
+func NewSearch(query string) *SearchBuilder {
+	return &SearchBuilder{query: query}
+}
+
+func (b *SearchBuilder) Depth(depth SearchDepth) *SearchBuilder {
+	b.opts.SearchDepth = string(depth)
+	return b
+}
+
+func (b *SearchBuilder) Topic(topic Topic) *SearchBuilder {
+	b.opts.Topic = string(topic)
+	return b
+}
+
+func (b *SearchBuilder) TimeRange(r TimeRange) *SearchBuilder {
+	b.opts.TimeRange = string(r)
+	return b
+}
+
+// Within limits results to the given look-back window.
+func (b *SearchBuilder) Within(d time.Duration) *SearchBuilder {
+	b.opts.Within = d
+	return b
+}
+
+func (b *SearchBuilder) Days(days int) *SearchBuilder {
+	b.opts.Days = days
+	return b
+}
+
+func (b *SearchBuilder) MaxResults(n int) *SearchBuilder {
+	b.opts.MaxResults = n
+	return b
+}
+
+func (b *SearchBuilder) IncludeDomains(domains ...string) *SearchBuilder {
+	b.opts.IncludeDomains = append(b.opts.IncludeDomains, domains...)
+	return b
+}
+
+func (b *SearchBuilder) ExcludeDomains(domains ...string) *SearchBuilder {
+	b.opts.ExcludeDomains = append(b.opts.ExcludeDomains, domains...)
+	return b
+}
+
+// Answer requests an AI-generated answer at the given depth.
+func (b *SearchBuilder) Answer(depth SearchDepth) *SearchBuilder {
+	b.opts.IncludeAnswer = string(depth)
+	return b
+}
+
+// RawContent requests each result's page content in the given format.
+func (b *SearchBuilder) RawContent(format Format) *SearchBuilder {
+	b.opts.IncludeRawContent = string(format)
+	return b
+}
+
+// Images requests query-related images, with descriptions if asked for.
+func (b *SearchBuilder) Images(descriptions bool) *SearchBuilder {
+	b.opts.IncludeImages = BoolPtr(true)
+	if descriptions {
+		b.opts.IncludeImageDescriptions = BoolPtr(true)
+	}
+	return b
+}
+
+func (b *SearchBuilder) Country(country Country) *SearchBuilder {
+	b.opts.Country = string(country)
+	return b
+}
+
+// Options merges opts over the options built so far.
+func (b *SearchBuilder) Options(opts *SearchOptions) *SearchBuilder {
+	b.opts = *b.opts.Merge(opts)
+	return b
+}
+
+// Build returns the query and a copy of the options built so far.
+func (b *SearchBuilder) Build() (string, *SearchOptions) {
+	return b.query, b.opts.Clone()
+}
+
+// Do runs the search with s, typically a *Client.
+func (b *SearchBuilder) Do(ctx context.Context, s Searcher) (*SearchResponse, error) {
+	query, opts := b.Build()
+	return s.Search(ctx, query, opts)
+}
+
+// ExtractBuilder builds an extract call fluently; see SearchBuilder.
+type ExtractBuilder struct {
+	urls []string
+	opts ExtractOptions
+}
+
+// NewExtract starts building an extraction of urls.
+func NewExtract(urls ...string) *ExtractBuilder {
+	return &ExtractBuilder{urls: urls}
+}
+
+func (b *ExtractBuilder) Depth(depth ExtractDepth) *ExtractBuilder {
+	b.opts.ExtractDepth = string(depth)
+	return b
+}
+
+func (b *ExtractBuilder) Format(format Format) *ExtractBuilder {
+	b.opts.Format = string(format)
+	return b
+}
+
+func (b *ExtractBuilder) Images() *ExtractBuilder {
+	b.opts.IncludeImages = BoolPtr(true)
+	return b
+}
+
+// LocalFallback extracts URLs the API failed on locally with extractor.
+func (b *ExtractBuilder) LocalFallback(extractor ContentExtractor) *ExtractBuilder {
+	b.opts.LocalFallback = extractor
+	return b
+}
+
+// Options merges opts over the options built so far.
+func (b *ExtractBuilder) Options(opts *ExtractOptions) *ExtractBuilder {
+	b.opts = *b.opts.Merge(opts)
+	return b
+}
+
+// Build returns the URLs and a copy of the options built so far.
+func (b *ExtractBuilder) Build() ([]string, *ExtractOptions) {
+	return append([]string(nil), b.urls...), b.opts.Clone()
+}
+
+// Do runs the extraction with e, typically a *Client.
+func (b *ExtractBuilder) Do(ctx context.Context, e Extractor) (*ExtractResponse, error) {
+	urls, opts := b.Build()
+	return e.Extract(ctx, urls, opts)
+}
+
+// CrawlBuilder builds a crawl call fluently; see SearchBuilder.
+type CrawlBuilder struct {
+	url  string
+	opts CrawlOptions
+}
+
+// NewCrawl starts building a crawl from url.
+func NewCrawl(url string) *CrawlBuilder {
+	return &CrawlBuilder{url: url}
+}
+
+func (b *CrawlBuilder) MaxDepth(n int) *CrawlBuilder {
+	b.opts.MaxDepth = n
+	return b
+}
+
+func (b *CrawlBuilder) MaxBreadth(n int) *CrawlBuilder {
+	b.opts.MaxBreadth = n
+	return b
+}
+
+func (b *CrawlBuilder) Limit(n int) *CrawlBuilder {
+	b.opts.Limit = n
+	return b
+}
+
+func (b *CrawlBuilder) Instructions(instructions string) *CrawlBuilder {
+	b.opts.Instructions = instructions
+	return b
+}
+
+func (b *CrawlBuilder) SelectPaths(patterns ...string) *CrawlBuilder {
+	b.opts.SelectPaths = append(b.opts.SelectPaths, patterns...)
+	return b
+}
+
+func (b *CrawlBuilder) ExcludePaths(patterns ...string) *CrawlBuilder {
+	b.opts.ExcludePaths = append(b.opts.ExcludePaths, patterns...)
+	return b
+}
+
+func (b *CrawlBuilder) Categories(categories ...CrawlCategory) *CrawlBuilder {
+	b.opts.Categories = append(b.opts.Categories, categories...)
+	return b
+}
+
+func (b *CrawlBuilder) Format(format Format) *CrawlBuilder {
+	b.opts.Format = string(format)
+	return b
+}
+
+// Options merges opts over the options built so far.
+func (b *CrawlBuilder) Options(opts *CrawlOptions) *CrawlBuilder {
+	b.opts = *b.opts.Merge(opts)
+	return b
+}
+
+// Build returns the URL and a copy of the options built so far.
+func (b *CrawlBuilder) Build() (string, *CrawlOptions) {
+	return b.url, b.opts.Clone()
+}
+
+// Do runs the crawl with c, typically a *Client.
+func (b *CrawlBuilder) Do(ctx context.Context, c Crawler) (*CrawlResponse, error) {
+	url, opts := b.Build()
+	return c.Crawl(ctx, url, opts)
+}
+
+// MapBuilder builds a map call fluently; see SearchBuilder.
+type MapBuilder struct {
+	url  string
+	opts MapOptions
+}
+
+// NewMap starts building a map of the site at url.
+func NewMap(url string) *MapBuilder {
+	return &MapBuilder{url: url}
+}
+
+func (b *MapBuilder) MaxDepth(n int) *MapBuilder {
+	b.opts.MaxDepth = n
+	return b
+}
+
+func (b *MapBuilder) MaxBreadth(n int) *MapBuilder {
+	b.opts.MaxBreadth = n
+	return b
+}
+
+func (b *MapBuilder) Limit(n int) *MapBuilder {
+	b.opts.Limit = n
+	return b
+}
+
+func (b *MapBuilder) Instructions(instructions string) *MapBuilder {
+	b.opts.Instructions = instructions
+	return b
+}
+
+func (b *MapBuilder) SelectPaths(patterns ...string) *MapBuilder {
+	b.opts.SelectPaths = append(b.opts.SelectPaths, patterns...)
+	return b
+}
+
+func (b *MapBuilder) ExcludePaths(patterns ...string) *MapBuilder {
+	b.opts.ExcludePaths = append(b.opts.ExcludePaths, patterns...)
+	return b
+}
+
+func (b *MapBuilder) Categories(categories ...CrawlCategory) *MapBuilder {
+	b.opts.Categories = append(b.opts.Categories, categories...)
+	return b
+}
+
+// Options merges opts over the options built so far.
+func (b *MapBuilder) Options(opts *MapOptions) *MapBuilder {
+	b.opts = *b.opts.Merge(opts)
+	return b
+}
+
+// Build returns the URL and a copy of the options built so far.
+func (b *MapBuilder) Build() (string, *MapOptions) {
+	return b.url, b.opts.Clone()
+}
+
+// Do runs the map with m, typically a *Client.
+func (b *MapBuilder) Do(ctx context.Context, m Mapper) (*MapResponse, error) {
+	url, opts := b.Build()
+	return m.Map(ctx, url, opts)
+}
@@ -0,0 +1,77 @@
+package tavily
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type fakeSearcher struct {
+	query string
+	opts  *SearchOptions
+}
+
+func (f *fakeSearcher) Search(_ context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	f.query, f.opts = query, opts
+	return &SearchResponse{Query: query}, nil
+}
+
+func TestSearchBuilder(t *testing.T) {
+	searcher := &fakeSearcher{}
+	b := NewSearch("golang generics").
+		Depth(SearchDepthAdvanced).
+		Topic(TopicNews).
+		Within(48 * time.Hour).
+		MaxResults(10).
+		IncludeDomains("go.dev").
+		Answer(SearchDepthBasic).
+		Images(false).
+		Options(&SearchOptions{Dedup: true})
+
+	if _, err := b.Do(context.Background(), searcher); err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+
+	want := &SearchOptions{
+		SearchDepth:    "advanced",
+		Topic:          "news",
+		Within:         48 * time.Hour,
+		MaxResults:     10,
+		IncludeDomains: []string{"go.dev"},
+		IncludeAnswer:  "basic",
+		IncludeImages:  BoolPtr(true),
+		Dedup:          true,
+	}
+	if searcher.query != "golang generics" {
+		t.Errorf("Do() query = %v, want %v", searcher.query, "golang generics")
+	}
+	if !reflect.DeepEqual(searcher.opts, want) {
+		t.Errorf("Do() opts = %+v, want %+v", searcher.opts, want)
+	}
+
+	// The builder can be reused without later calls leaking into earlier ones.
+	b.IncludeDomains("github.com")
+	if len(searcher.opts.IncludeDomains) != 1 {
+		t.Errorf("builder mutation changed options already passed to Do(): %v", searcher.opts.IncludeDomains)
+	}
+}
+
+func TestCrawlBuilder(t *testing.T) {
+	url, opts := NewCrawl("https://go.dev").
+		MaxDepth(Zero).
+		Limit(5).
+		SelectPaths("/doc/.*").
+		Categories(CategoryDocumentation).
+		Build()
+
+	want := &CrawlOptions{
+		MaxDepth:    Zero,
+		Limit:       5,
+		SelectPaths: []string{"/doc/.*"},
+		Categories:  []CrawlCategory{CategoryDocumentation},
+	}
+	if url != "https://go.dev" || !reflect.DeepEqual(opts, want) {
+		t.Errorf("Build() = %v, %+v, want %v, %+v", url, opts, "https://go.dev", want)
+	}
+}
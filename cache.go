@@ -0,0 +1,82 @@
+package tavily
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrCacheMiss is returned in offline mode when a request has no cached response.
+var ErrCacheMiss = errors.New("tavily: no cached response")
+
+// Cache stores raw API responses keyed by a hash of the request.
+// Implementations must be safe for concurrent use.
+type Cache interface {
+	// Get returns the cached response for key.
+	Get(key string) ([]byte, bool)
+	// Set stores a response for key. A ttl of zero means no expiry.
+	Set(key string, value []byte, ttl time.Duration)
+}
+
+// DefaultTopicTTLs returns cache lifetimes matched to how quickly each
+// topic's results go stale, for Options.TopicTTL: minutes for news, seconds
+// to minutes for finance and hours for general searches.
+func DefaultTopicTTLs() map[Topic]time.Duration {
+	return map[Topic]time.Duration{
+		TopicGeneral: 6 * time.Hour,
+		TopicNews:    10 * time.Minute,
+		TopicFinance: time.Minute,
+	}
+}
+
+// cacheTTLFor returns how long the response to request may be cached: the
+// TopicTTL entry for a search's topic, or CacheTTL.
+func (c *Client) cacheTTLFor(request any) time.Duration {
+	if req, ok := request.(*SearchRequest); ok {
+		if ttl, ok := c.topicTTL[Topic(strings.ToLower(req.Topic))]; ok {
+			return ttl
+		}
+	}
+	return c.cacheTTL
+}
+
+type cacheEntry struct {
+	value   []byte
+	expires time.Time
+}
+
+// MemoryCache is an in-process Cache. The zero value is ready to use.
+type MemoryCache struct {
+	// Clock decides when entries expire. Nil uses SystemClock.
+	Clock Clock
+
+	mu      sync.RWMutex
+	entries map[string]cacheEntry
+}
+
+// Get implements Cache.
+func (c *MemoryCache) Get(key string) ([]byte, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	e, ok := c.entries[key]
+	if !ok || (!e.expires.IsZero() && clockOr(c.Clock).Now().After(e.expires)) {
+		return nil, false
+	}
+	return slices.Clone(e.value), true
+}
+
+// Set implements Cache.
+func (c *MemoryCache) Set(key string, value []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]cacheEntry)
+	}
+	e := cacheEntry{value: slices.Clone(value)}
+	if ttl > 0 {
+		e.expires = clockOr(c.Clock).Now().Add(ttl)
+	}
+	c.entries[key] = e
+}
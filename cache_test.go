@@ -0,0 +1,183 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCacheAndOfflineMode(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "cached", "results": [{"url": "https://example.com", "score": 0.9}]}`))
+	}))
+	defer server.Close()
+
+	cache := &MemoryCache{}
+	online := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Cache:   cache,
+	})
+
+	ctx := context.Background()
+	for range 2 {
+		if _, err := online.Search(ctx, "cached", nil); err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("Search() made %d requests, want 1", calls)
+	}
+
+	offline := New("", &Options{
+		BaseURL: "http://127.0.0.1:1",
+		Cache:   cache,
+		Offline: true,
+	})
+
+	result, err := offline.Search(ctx, "cached", nil)
+	if err != nil {
+		t.Fatalf("offline Search() error = %v", err)
+	}
+	if !result.Meta.CacheHit || result.Meta.Attempts != 0 || len(result.Results) != 1 {
+		t.Errorf("offline Search() = %+v", result)
+	}
+
+	_, err = offline.Search(ctx, "not cached", nil)
+	if !errors.Is(err, ErrCacheMiss) {
+		t.Errorf("offline Search() error = %v, want %v", err, ErrCacheMiss)
+	}
+}
+
+func TestCacheKeyNormalization(t *testing.T) {
+	base := &SearchRequest{
+		Query:          "golang generics",
+		SearchDepth:    "basic",
+		Topic:          "general",
+		MaxResults:     Int(5),
+		IncludeDomains: []string{"go.dev", "github.com"},
+		IncludeAnswer:  true,
+		Timeout:        Int(60),
+	}
+
+	tests := []struct {
+		name     string
+		request  any
+		foldCase bool
+		want     bool
+	}{
+		{
+			name: "defaults omitted and lists reordered",
+			request: &SearchRequest{
+				Query:          "  golang   generics ",
+				IncludeDomains: []string{"GitHub.com", "go.dev", "go.dev"},
+				IncludeAnswer:  "basic",
+				Timeout:        Int(30),
+			},
+			want: true,
+		},
+		{
+			name:    "enum case",
+			request: &SearchRequest{Query: "golang generics", SearchDepth: "BASIC", IncludeDomains: []string{"github.com", "go.dev"}, IncludeAnswer: true},
+			want:    true,
+		},
+		{
+			name:     "query case folded",
+			request:  &SearchRequest{Query: "Golang Generics", IncludeDomains: []string{"github.com", "go.dev"}, IncludeAnswer: true},
+			foldCase: true,
+			want:     true,
+		},
+		{
+			name:    "query case kept",
+			request: &SearchRequest{Query: "Golang Generics", IncludeDomains: []string{"github.com", "go.dev"}, IncludeAnswer: true},
+			want:    false,
+		},
+		{
+			name:    "different depth",
+			request: &SearchRequest{Query: "golang generics", SearchDepth: "advanced", IncludeDomains: []string{"github.com", "go.dev"}, IncludeAnswer: true},
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			want, _ := CacheKey("/search", base, tt.foldCase)
+			got, err := CacheKey("search", tt.request, tt.foldCase)
+			if err != nil {
+				t.Fatalf("CacheKey() error = %v", err)
+			}
+			if (got == want) != tt.want {
+				t.Errorf("CacheKey() equal = %v, want %v", got == want, tt.want)
+			}
+		})
+	}
+}
+
+// ttlRecordingCache records the TTL of every Set.
+type ttlRecordingCache struct {
+	MemoryCache
+	ttls []time.Duration
+}
+
+func (c *ttlRecordingCache) Set(key string, value []byte, ttl time.Duration) {
+	c.ttls = append(c.ttls, ttl)
+	c.MemoryCache.Set(key, value, ttl)
+}
+
+func TestTopicTTL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": [], "urls": []}`))
+	}))
+	defer server.Close()
+
+	topicTTL := DefaultTopicTTLs()
+	delete(topicTTL, TopicGeneral)
+
+	tests := []struct {
+		name string
+		call func(*Client) error
+		want time.Duration
+	}{
+		{"news search", func(c *Client) error {
+			_, err := c.Search(context.Background(), "q", &SearchOptions{Topic: "news"})
+			return err
+		}, 10 * time.Minute},
+		{"finance search", func(c *Client) error {
+			_, err := c.Search(context.Background(), "q", &SearchOptions{Topic: "Finance"})
+			return err
+		}, time.Minute},
+		{"topic without entry", func(c *Client) error {
+			_, err := c.Search(context.Background(), "q", nil)
+			return err
+		}, time.Hour},
+		{"map", func(c *Client) error {
+			_, err := c.Map(context.Background(), "https://example.com", nil)
+			return err
+		}, time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := &ttlRecordingCache{}
+			client := New("tvly-test-key", &Options{
+				BaseURL:  server.URL,
+				Cache:    cache,
+				CacheTTL: time.Hour,
+				TopicTTL: topicTTL,
+			})
+			if err := tt.call(client); err != nil {
+				t.Fatalf("call error = %v", err)
+			}
+			if len(cache.ttls) != 1 || cache.ttls[0] != tt.want {
+				t.Errorf("cache Set ttls = %v, want [%v]", cache.ttls, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,148 @@
+package tavily
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"slices"
+	"strings"
+)
+
+// ignoredKeyFields do not influence the response and are left out of cache keys.
+var ignoredKeyFields = []string{"timeout"}
+
+// enumKeyFields hold case-insensitive enum values.
+var enumKeyFields = []string{"search_depth", "topic", "time_range", "extract_depth", "format", "country"}
+
+// setKeyFields are lists whose order does not matter to the API.
+var setKeyFields = []string{"include_domains", "exclude_domains", "select_paths", "select_domains", "exclude_paths", "categories"}
+
+// CacheKey returns the canonical cache key for a request sent to endpoint.
+// Semantically identical requests share a key even when spelled differently:
+// object keys are sorted, enum values lowercased, order-insensitive lists sorted
+// and deduplicated (domains case-insensitively), whitespace in queries and
+// instructions collapsed, and options equal to their API defaults dropped. With
+// foldCase the query is also compared case-insensitively.
+func CacheKey(endpoint string, request any, foldCase bool) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	return cacheKey(endpoint, data, foldCase), nil
+}
+
+func cacheKey(endpoint string, payload []byte, foldCase bool) string {
+	h := sha256.New()
+	h.Write([]byte("/" + strings.TrimPrefix(endpoint, "/")))
+	h.Write([]byte{0})
+	h.Write(canonicalPayload(payload, foldCase))
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// canonicalPayload normalizes a JSON request body. Payloads that are not JSON
+// objects are returned unchanged.
+func canonicalPayload(payload []byte, foldCase bool) []byte {
+	var fields map[string]any
+	if err := json.Unmarshal(payload, &fields); err != nil {
+		return payload
+	}
+
+	for _, name := range ignoredKeyFields {
+		delete(fields, name)
+	}
+	for _, name := range enumKeyFields {
+		if s, ok := fields[name].(string); ok {
+			fields[name] = strings.ToLower(strings.TrimSpace(s))
+		}
+	}
+	for _, name := range setKeyFields {
+		if list, ok := fields[name].([]any); ok {
+			fields[name] = canonicalSet(list, strings.HasSuffix(name, "_domains"))
+		}
+	}
+	for _, name := range []string{"query", "instructions"} {
+		if s, ok := fields[name].(string); ok {
+			s = strings.Join(strings.Fields(s), " ")
+			if foldCase && name == "query" {
+				s = strings.ToLower(s)
+			}
+			fields[name] = s
+		}
+	}
+
+	// Spellings the API treats as equivalent.
+	if fields["include_answer"] == true {
+		fields["include_answer"] = "basic"
+	}
+	if fields["include_raw_content"] == true {
+		fields["include_raw_content"] = string(FormatMarkdown)
+	}
+
+	for name, value := range fields {
+		if isDefaultKeyValue(name, value) {
+			delete(fields, name)
+		}
+	}
+
+	// Marshalling a map sorts its keys.
+	canonical, err := json.Marshal(fields)
+	if err != nil {
+		return payload
+	}
+	return canonical
+}
+
+func canonicalSet(list []any, foldCase bool) []any {
+	values := make([]string, 0, len(list))
+	for _, v := range list {
+		s, ok := v.(string)
+		if !ok {
+			return list
+		}
+		s = strings.TrimSpace(s)
+		if foldCase {
+			s = strings.ToLower(s)
+		}
+		values = append(values, s)
+	}
+	slices.Sort(values)
+	values = slices.Compact(values)
+
+	out := make([]any, len(values))
+	for i, s := range values {
+		out[i] = s
+	}
+	return out
+}
+
+// intKeyDefaults are the API defaults of int fields whose 0 is meaningful.
+var intKeyDefaults = map[string]float64{
+	"max_results": DefaultMaxResults,
+	"max_depth":   1,
+	"max_breadth": 20,
+	"limit":       50,
+}
+
+func isDefaultKeyValue(name string, value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case bool:
+		return !v && name != "allow_external"
+	case string:
+		return v == "" ||
+			name == "search_depth" && v == DefaultSearchDepth ||
+			name == "extract_depth" && v == DefaultExtractDepth ||
+			name == "topic" && v == DefaultTopic ||
+			name == "format" && v == DefaultFormat
+	case float64:
+		// An explicit 0 for these differs from omitting them.
+		if def, ok := intKeyDefaults[name]; ok {
+			return v == def
+		}
+		return v == 0
+	case []any:
+		return len(v) == 0
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// Call posts req to path and decodes the JSON reply into a new TResp. It runs
+// through the same pipeline as the built-in endpoints (authentication, request
+// IDs, caching, quotas, retries and failover), so it can be used for endpoints
+// this package does not wrap yet. Use CallMethod for endpoints read with GET:
+//
+//	type UsageResponse struct{ Credits int `json:"credits"` }
+//	usage, err := tavily.CallMethod[url.Values, UsageResponse](ctx, client, http.MethodGet, "/usage", nil)
+//
+// A leading slash on path is optional.
+func Call[TReq, TResp any](ctx context.Context, c *Client, path string, req TReq) (*TResp, error) {
+	return CallMethod[TReq, TResp](ctx, c, http.MethodPost, path, req)
+}
+
+// CallMethod is Call with an explicit HTTP method. For GET, HEAD and DELETE,
+// req is sent as a query string encoded with QueryValues instead of a JSON
+// body, which suits status and account endpoints:
+//
+//	job, err := tavily.CallMethod[url.Values, JobStatus](ctx, client, http.MethodGet,
+//		"/jobs", url.Values{"id": {jobID}})
+func CallMethod[TReq, TResp any](ctx context.Context, c *Client, method, path string, req TReq) (*TResp, error) {
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	var resp TResp
+	if err := c.doRequest(ctx, method, path, req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// bodyless reports whether requests with method carry their payload in the query string.
+func bodyless(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// QueryValues encodes v as query parameters named by its JSON encoding, so
+// request structs reuse their json tags (and omitempty) for GET requests.
+// Lists become repeated parameters; nulls are left out. v may also be a
+// url.Values or map[string]string, used as is. Nested objects are rejected.
+func QueryValues(v any) (url.Values, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case url.Values:
+		return v, nil
+	case map[string]string:
+		values := make(url.Values, len(v))
+		for k, s := range v {
+			values.Set(k, s)
+		}
+		return values, nil
+	}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, fmt.Errorf("query parameters must be an object, got %s", data)
+	}
+
+	values := make(url.Values, len(fields))
+	for k, field := range fields {
+		items, ok := field.([]any)
+		if !ok {
+			items = []any{field}
+		}
+		for _, item := range items {
+			s, ok, err := queryValue(item)
+			if err != nil {
+				return nil, fmt.Errorf("query parameter %s: %w", k, err)
+			}
+			if ok {
+				values.Add(k, s)
+			}
+		}
+	}
+	return values, nil
+}
+
+func queryValue(v any) (string, bool, error) {
+	switch v := v.(type) {
+	case nil:
+		return "", false, nil
+	case string:
+		return v, true, nil
+	case bool:
+		return strconv.FormatBool(v), true, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), true, nil
+	default:
+		return "", false, fmt.Errorf("unsupported nested value %v", v)
+	}
+}
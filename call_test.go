@@ -0,0 +1,144 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCall(t *testing.T) {
+	type usageRequest struct {
+		Period string `json:"period"`
+	}
+	type usageResponse struct {
+		Credits int `json:"credits"`
+	}
+
+	var gotPath string
+	var gotBody usageRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotPath = r.URL.Path
+		json.NewDecoder(r.Body).Decode(&gotBody)
+		if r.Header.Get(RequestIDHeader) == "" {
+			t.Errorf("Call() sent no X-Request-ID header")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"credits": 42}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+
+	resp, err := Call[usageRequest, usageResponse](context.Background(), client, "usage", usageRequest{Period: "month"})
+	if err != nil {
+		t.Fatalf("Call() error = %v", err)
+	}
+	if resp.Credits != 42 {
+		t.Errorf("Call() credits = %v, want %v", resp.Credits, 42)
+	}
+	if gotPath != "/usage" {
+		t.Errorf("Call() path = %v, want %v", gotPath, "/usage")
+	}
+	if gotBody.Period != "month" {
+		t.Errorf("Call() body period = %v, want %v", gotBody.Period, "month")
+	}
+}
+
+func TestCallError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"detail": {"error": "bad period"}}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+
+	_, err := Call[struct{}, struct{}](context.Background(), client, "/usage", struct{}{})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Call() error = %T, want *APIError", err)
+	}
+	if apiErr.StatusCode != http.StatusBadRequest {
+		t.Errorf("Call() status = %v, want %v", apiErr.StatusCode, http.StatusBadRequest)
+	}
+}
+
+func TestCallMethodGet(t *testing.T) {
+	type jobRequest struct {
+		ID     string   `json:"id"`
+		Fields []string `json:"fields,omitempty"`
+		Limit  *int     `json:"limit,omitempty"`
+	}
+	type jobStatus struct {
+		Status string `json:"status"`
+	}
+
+	var gotMethod, gotQuery string
+	var gotBody int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethod, gotQuery, gotBody = r.Method, r.URL.RawQuery, r.ContentLength
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"status": "done"}`))
+	}))
+	defer server.Close()
+
+	cache := &MemoryCache{}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Cache: cache})
+
+	req := jobRequest{ID: "job 1", Fields: []string{"a", "b"}}
+	resp, err := CallMethod[jobRequest, jobStatus](context.Background(), client, http.MethodGet, "/jobs", req)
+	if err != nil {
+		t.Fatalf("CallMethod() error = %v", err)
+	}
+	if resp.Status != "done" {
+		t.Errorf("CallMethod() status = %v, want %v", resp.Status, "done")
+	}
+	if gotMethod != http.MethodGet || gotBody != 0 {
+		t.Errorf("CallMethod() sent %s with %d body bytes, want GET without a body", gotMethod, gotBody)
+	}
+	if want := "fields=a&fields=b&id=job+1"; gotQuery != want {
+		t.Errorf("CallMethod() query = %v, want %v", gotQuery, want)
+	}
+
+	gotMethod = ""
+	if _, err := CallMethod[jobRequest, jobStatus](context.Background(), client, http.MethodGet, "/jobs", req); err != nil || gotMethod != "" {
+		t.Errorf("repeated GET error = %v, method = %q, want a cache hit", err, gotMethod)
+	}
+	if _, err := Call[jobRequest, jobStatus](context.Background(), client, "/jobs", req); err != nil || gotMethod != http.MethodPost {
+		t.Errorf("POST after GET error = %v, method = %q, want POST to miss the GET cache entry", err, gotMethod)
+	}
+}
+
+func TestQueryValues(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   any
+		want    string
+		wantErr bool
+	}{
+		{"nil", nil, "", false},
+		{"struct", struct {
+			Q     string  `json:"q"`
+			Score float64 `json:"score"`
+			On    bool    `json:"on"`
+			Skip  *int    `json:"skip"`
+		}{Q: "go", Score: 0.5, On: true}, "on=true&q=go&score=0.5", false},
+		{"map", map[string]string{"a": "1"}, "a=1", false},
+		{"nested", map[string]any{"obj": map[string]any{"x": 1}}, "", true},
+		{"not an object", []string{"a"}, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := QueryValues(tt.input)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("QueryValues(%s) error = %v, wantErr %v", tt.name, err, tt.wantErr)
+			continue
+		}
+		if got.Encode() != tt.want {
+			t.Errorf("QueryValues(%s) = %v, want %v", tt.name, got.Encode(), tt.want)
+		}
+	}
+}
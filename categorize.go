@@ -0,0 +1,100 @@
+package tavily
+
+import (
+	"net/url"
+	"strings"
+)
+
+// categoryKeywords lists the path segments and subdomain labels that usually
+// indicate a category. Matching is on whole segments, case-insensitively.
+var categoryKeywords = map[CrawlCategory][]string{
+	CategoryDocumentation:  {"docs", "doc", "documentation", "guide", "guides", "reference", "manual", "tutorial", "tutorials"},
+	CategoryBlog:           {"blog", "blogs", "posts", "articles", "news", "changelog"},
+	CategoryCommunity:      {"community", "forum", "forums", "discuss", "discussions"},
+	CategoryAbout:          {"about", "about-us", "company"},
+	CategoryContact:        {"contact", "contact-us", "support"},
+	CategoryPrivacy:        {"privacy", "privacy-policy", "cookies"},
+	CategoryTerms:          {"terms", "tos", "legal", "terms-of-service"},
+	CategoryStatus:         {"status"},
+	CategoryPricing:        {"pricing", "plans"},
+	CategoryEnterprise:     {"enterprise"},
+	CategoryCareers:        {"careers", "jobs", "join-us", "hiring"},
+	CategoryECommerce:      {"shop", "store", "cart", "checkout", "products"},
+	CategoryAuthentication: {"login", "signin", "sign-in", "signup", "sign-up", "register", "auth", "oauth"},
+	CategoryDeveloper:      {"developer", "developers", "dev", "api", "sdk"},
+	CategorySolutions:      {"solutions", "use-cases", "industries"},
+	CategoryPartners:       {"partners", "partner", "integrations"},
+	CategoryDownloads:      {"download", "downloads", "releases"},
+	CategoryMedia:          {"media", "press", "videos", "podcast"},
+	CategoryEvents:         {"events", "event", "webinars", "conference"},
+	CategoryPeople:         {"team", "people", "leadership", "authors"},
+}
+
+// categoryByKeyword inverts categoryKeywords for lookups.
+var categoryByKeyword = func() map[string]CrawlCategory {
+	index := make(map[string]CrawlCategory)
+	for category, keywords := range categoryKeywords {
+		for _, k := range keywords {
+			index[k] = category
+		}
+	}
+	return index
+}()
+
+// DetectCategory guesses the CrawlCategory of rawURL from its path and
+// subdomain, locally and without an API call. The first path segment with a
+// known meaning wins, then the leftmost subdomain label (docs.example.com).
+// It returns false when no heuristic matches.
+func DetectCategory(rawURL string) (CrawlCategory, bool) {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		u, err = url.Parse("https://" + rawURL)
+		if err != nil {
+			return "", false
+		}
+	}
+
+	for _, segment := range strings.Split(strings.ToLower(u.Path), "/") {
+		segment = strings.TrimSuffix(segment, ".html")
+		if c, ok := categoryByKeyword[segment]; ok {
+			return c, true
+		}
+	}
+
+	labels := strings.Split(strings.ToLower(u.Hostname()), ".")
+	if len(labels) > 2 {
+		if c, ok := categoryByKeyword[labels[0]]; ok {
+			return c, true
+		}
+	}
+	return "", false
+}
+
+// ByCategory groups the mapped URLs by DetectCategory. URLs no heuristic
+// matches are left out.
+func (r *MapResponse) ByCategory() map[CrawlCategory][]string {
+	groups := make(map[CrawlCategory][]string)
+	for _, u := range r.Results {
+		if c, ok := DetectCategory(u); ok {
+			groups[c] = append(groups[c], u)
+		}
+	}
+	return groups
+}
+
+// FilterCategories returns the mapped URLs DetectCategory places in one of
+// categories, in their original order, ready for a follow-up Extract.
+func (r *MapResponse) FilterCategories(categories ...CrawlCategory) []string {
+	want := make(map[CrawlCategory]bool, len(categories))
+	for _, c := range categories {
+		want[c] = true
+	}
+
+	var urls []string
+	for _, u := range r.Results {
+		if c, ok := DetectCategory(u); ok && want[c] {
+			urls = append(urls, u)
+		}
+	}
+	return urls
+}
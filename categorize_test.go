@@ -0,0 +1,57 @@
+package tavily
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestDetectCategory(t *testing.T) {
+	tests := []struct {
+		url    string
+		want   CrawlCategory
+		wantOK bool
+	}{
+		{"https://example.com/docs/getting-started", CategoryDocumentation, true},
+		{"https://example.com/en/Blog/2024/launch", CategoryBlog, true},
+		{"https://example.com/careers", CategoryCareers, true},
+		{"https://example.com/pricing.html", CategoryPricing, true},
+		{"https://docs.example.com/intro", CategoryDocumentation, true},
+		{"https://example.com/blog/docs-are-great", CategoryBlog, true},
+		{"example.com/login?next=/", CategoryAuthentication, true},
+		{"https://status.example.io/", CategoryStatus, true},
+		{"https://example.com/products-overview", "", false},
+		{"https://docs.com/", "", false},
+		{"https://example.com/", "", false},
+	}
+
+	for _, tt := range tests {
+		got, ok := DetectCategory(tt.url)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("DetectCategory(%q) = %q, %v, want %q, %v", tt.url, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestMapResponseCategories(t *testing.T) {
+	resp := &MapResponse{Results: []string{
+		"https://example.com/docs/a",
+		"https://example.com/blog/b",
+		"https://example.com/",
+		"https://docs.example.com/c",
+		"https://example.com/careers",
+	}}
+
+	groups := resp.ByCategory()
+	if got := groups[CategoryDocumentation]; !slices.Equal(got, []string{"https://example.com/docs/a", "https://docs.example.com/c"}) {
+		t.Errorf("ByCategory()[Documentation] = %v", got)
+	}
+	if len(groups) != 3 {
+		t.Errorf("len(ByCategory()) = %d, want 3", len(groups))
+	}
+
+	got := resp.FilterCategories(CategoryBlog, CategoryCareers)
+	want := []string{"https://example.com/blog/b", "https://example.com/careers"}
+	if !slices.Equal(got, want) {
+		t.Errorf("FilterCategories() = %v, want %v", got, want)
+	}
+}
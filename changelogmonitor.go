@@ -0,0 +1,253 @@
+package tavily
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultChangelogInterval is how often ChangelogMonitor.Run checks by default.
+const DefaultChangelogInterval = time.Hour
+
+// ReleaseEventKind tells whether a changelog entry is new or was edited.
+type ReleaseEventKind string
+
+const (
+	ReleaseNew     ReleaseEventKind = "new"
+	ReleaseChanged ReleaseEventKind = "changed"
+)
+
+// ReleaseEvent reports a changelog entry that appeared or changed since the
+// previous check.
+type ReleaseEvent struct {
+	Kind ReleaseEventKind `json:"kind"`
+	// URL is the page the entry is on.
+	URL string `json:"url"`
+	// Title is the entry's heading, or "" for a page without headings.
+	Title string `json:"title,omitempty"`
+	// Version is the first version number in the heading or, failing that,
+	// the entry, e.g. "v1.4.0".
+	Version string `json:"version,omitempty"`
+	// Content is the entry's text, heading included.
+	Content string `json:"content"`
+	// Digest and PreviousDigest are SHA-256 hashes of the entry, as
+	// "sha256:<hex>"; PreviousDigest is set for ReleaseChanged.
+	Digest         string    `json:"digest"`
+	PreviousDigest string    `json:"previous_digest,omitempty"`
+	DetectedAt     time.Time `json:"detected_at"`
+}
+
+// ReleaseHandler receives release events from ChangelogMonitor.Run.
+type ReleaseHandler interface {
+	HandleRelease(ctx context.Context, event ReleaseEvent) error
+}
+
+// ReleaseHandlerFunc adapts a function to ReleaseHandler.
+type ReleaseHandlerFunc func(ctx context.Context, event ReleaseEvent) error
+
+// HandleRelease implements ReleaseHandler.
+func (f ReleaseHandlerFunc) HandleRelease(ctx context.Context, event ReleaseEvent) error {
+	return f(ctx, event)
+}
+
+// ChangelogState maps each changelog page URL to the digests of its entries,
+// keyed by heading. It is plain JSON, so a monitor's state can be persisted
+// between runs.
+type ChangelogState map[string]map[string]string
+
+// ChangelogMonitor watches a product's changelog or release notes and reports
+// each new or edited entry, for DevRel and competitive tracking. Pages are
+// split into entries at markdown headings, so one long changelog page yields
+// one event per release. The first check only records a baseline.
+// A ChangelogMonitor is safe for concurrent use.
+type ChangelogMonitor struct {
+	Client *Client
+	// URL is the site or changelog page crawled with CrawlChangelog.
+	URL string
+	// MaxPages caps the crawl; see CrawlChangelog.
+	MaxPages int
+	// Pages, if set, are extracted instead of crawling URL.
+	Pages []string
+	// Interval is the time between checks made by Run. Defaults to
+	// DefaultChangelogInterval.
+	Interval time.Duration
+	// State holds the entries seen so far. Set it to resume from a
+	// persisted state; nil starts with a baseline check.
+	State ChangelogState
+
+	mu sync.Mutex
+}
+
+var (
+	markdownHeading = regexp.MustCompile(`(?m)^#{1,6}[ \t]+(.+?)[ \t#]*$`)
+	versionPattern  = regexp.MustCompile(`\bv?\d+\.\d+(?:\.\d+)?(?:-[0-9A-Za-z.]+)?\b`)
+)
+
+// Check fetches the changelog once and returns events for entries that are
+// new or changed since the previous check, in page order. Entries on pages
+// seen for the first time are new, except during the baseline check.
+func (m *ChangelogMonitor) Check(ctx context.Context) ([]ReleaseEvent, error) {
+	pages, err := m.fetch(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("changelog check failed: %w", err)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	baseline := m.State == nil
+	if baseline {
+		m.State = make(ChangelogState)
+	}
+	now := m.Client.clock.Now()
+
+	var events []ReleaseEvent
+	for _, page := range pages {
+		seen := m.State[page.url]
+		if seen == nil {
+			seen = make(map[string]string)
+			m.State[page.url] = seen
+		}
+		for _, e := range changelogEntries(page.content) {
+			sum := sha256.Sum256([]byte(e.content))
+			digest := "sha256:" + hex.EncodeToString(sum[:])
+			previous, known := seen[e.title]
+			seen[e.title] = digest
+			if baseline || previous == digest {
+				continue
+			}
+
+			event := ReleaseEvent{
+				Kind:       ReleaseNew,
+				URL:        page.url,
+				Title:      e.title,
+				Version:    versionPattern.FindString(e.title),
+				Content:    e.content,
+				Digest:     digest,
+				DetectedAt: now,
+			}
+			if event.Version == "" {
+				event.Version = versionPattern.FindString(e.content)
+			}
+			if known {
+				event.Kind = ReleaseChanged
+				event.PreviousDigest = previous
+			}
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+// Run checks the changelog every Interval, passing each event to handler,
+// until ctx is done or the client is closed, which returns ErrClientClosed.
+// It returns the first error from a check or the handler; transient API
+// failures are already retried by the client. Under PanicContinue a
+// panicking handler drops the event.
+func (m *ChangelogMonitor) Run(ctx context.Context, handler ReleaseHandler) error {
+	if m.Client == nil {
+		return errors.New("ChangelogMonitor has no Client")
+	}
+	ctx, stop := m.Client.lifecycle.untilClosed(ctx)
+	defer stop()
+
+	interval := m.Interval
+	if interval <= 0 {
+		interval = DefaultChangelogInterval
+	}
+	for {
+		events, err := m.Check(ctx)
+		if err != nil {
+			return err
+		}
+		for _, event := range events {
+			var err error
+			if perr := m.Client.runHook(ctx, "ReleaseHandler", func() { err = handler.HandleRelease(ctx, event) }); perr != nil {
+				if m.Client.abortOnPanic() {
+					return perr
+				}
+				continue
+			}
+			if err != nil {
+				return fmt.Errorf("release handler failed: %w", err)
+			}
+		}
+		if err := m.Client.clock.Sleep(ctx, interval); err != nil {
+			return context.Cause(ctx)
+		}
+	}
+}
+
+type changelogPage struct {
+	url, content string
+}
+
+func (m *ChangelogMonitor) fetch(ctx context.Context) ([]changelogPage, error) {
+	if m.Client == nil {
+		return nil, errors.New("ChangelogMonitor has no Client")
+	}
+
+	var pages []changelogPage
+	if len(m.Pages) > 0 {
+		resp, err := m.Client.Extract(ctx, m.Pages, &ExtractOptions{Format: string(FormatMarkdown)})
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Results {
+			pages = append(pages, changelogPage{r.URL, r.RawContent})
+		}
+	} else {
+		resp, err := m.Client.CrawlChangelog(ctx, m.URL, m.MaxPages)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range resp.Results {
+			pages = append(pages, changelogPage{r.URL, r.RawContent})
+		}
+	}
+	// Crawl order is not stable; sort so events come out the same way each time.
+	slices.SortFunc(pages, func(a, b changelogPage) int { return strings.Compare(a.url, b.url) })
+	return pages, nil
+}
+
+type changelogEntry struct {
+	title, content string
+}
+
+// changelogEntries splits markdown at its headings. Text before the first
+// heading is dropped when there are headings, since it is usually the page
+// intro; a page without headings is one entry. Repeated headings are
+// numbered so each keeps its own digest.
+func changelogEntries(markdown string) []changelogEntry {
+	locs := markdownHeading.FindAllStringSubmatchIndex(markdown, -1)
+	if len(locs) == 0 {
+		if content := strings.TrimSpace(markdown); content != "" {
+			return []changelogEntry{{content: content}}
+		}
+		return nil
+	}
+
+	entries := make([]changelogEntry, 0, len(locs))
+	titles := make(map[string]int)
+	for i, loc := range locs {
+		end := len(markdown)
+		if i+1 < len(locs) {
+			end = locs[i+1][0]
+		}
+		title := strings.TrimSpace(markdown[loc[2]:loc[3]])
+		if n := titles[title]; n > 0 {
+			titles[title]++
+			title = fmt.Sprintf("%s (%d)", title, n+1)
+		} else {
+			titles[title] = 1
+		}
+		entries = append(entries, changelogEntry{title: title, content: strings.TrimSpace(markdown[loc[0]:end])})
+	}
+	return entries
+}
@@ -0,0 +1,170 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestChangelogEntries(t *testing.T) {
+	entries := changelogEntries("Intro text.\n\n# Changelog\n\n## v1.2.0 ##\nFixes.\n\n## Unreleased\nWIP.\n## Unreleased\nMore.")
+	want := []changelogEntry{
+		{"Changelog", "# Changelog"},
+		{"v1.2.0", "## v1.2.0 ##\nFixes."},
+		{"Unreleased", "## Unreleased\nWIP."},
+		{"Unreleased (2)", "## Unreleased\nMore."},
+	}
+	if len(entries) != len(want) {
+		t.Fatalf("changelogEntries() = %+v, want %+v", entries, want)
+	}
+	for i := range want {
+		if entries[i] != want[i] {
+			t.Errorf("changelogEntries()[%d] = %+v, want %+v", i, entries[i], want[i])
+		}
+	}
+
+	if got := changelogEntries("  plain page  "); len(got) != 1 || got[0].title != "" || got[0].content != "plain page" {
+		t.Errorf("changelogEntries() without headings = %+v", got)
+	}
+}
+
+func TestChangelogMonitorCheck(t *testing.T) {
+	pages := map[string]string{
+		"https://example.com/changelog": "# Changelog\n\n## v1.0.0\nFirst release.",
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var results []CrawlResult
+		for url, content := range pages {
+			results = append(results, CrawlResult{URL: url, RawContent: content})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(CrawlResponse{BaseURL: "https://example.com", Results: results})
+	}))
+	defer server.Close()
+
+	at := time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC)
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: tavilytest.NewFakeClock(at)})
+	monitor := &ChangelogMonitor{Client: client, URL: "https://example.com"}
+	ctx := context.Background()
+
+	events, err := monitor.Check(ctx)
+	if err != nil || len(events) != 0 {
+		t.Fatalf("baseline Check() = %+v, %v, want no events", events, err)
+	}
+
+	pages["https://example.com/changelog"] = "# Changelog\n\n## v1.1.0 (2025-05-01)\nAdded search.\n\n## v1.0.0\nFirst release, fixed typo."
+	pages["https://example.com/changelog/2"] = "Older releases live here."
+	events, err = monitor.Check(ctx)
+	if err != nil {
+		t.Fatalf("Check() error = %v", err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("Check() = %+v, want 3 events", events)
+	}
+	if e := events[0]; e.Kind != ReleaseNew || e.Title != "v1.1.0 (2025-05-01)" || e.Version != "v1.1.0" ||
+		e.Content != "## v1.1.0 (2025-05-01)\nAdded search." || !e.DetectedAt.Equal(at) {
+		t.Errorf("Check() new release = %+v", e)
+	}
+	if e := events[1]; e.Kind != ReleaseChanged || e.Version != "v1.0.0" || e.PreviousDigest == "" || e.PreviousDigest == e.Digest {
+		t.Errorf("Check() edited release = %+v", e)
+	}
+	if e := events[2]; e.Kind != ReleaseNew || e.URL != "https://example.com/changelog/2" || e.Title != "" {
+		t.Errorf("Check() new page = %+v", e)
+	}
+
+	if events, _ := monitor.Check(ctx); len(events) != 0 {
+		t.Errorf("Check() without changes = %+v, want none", events)
+	}
+
+	// A monitor resumed from persisted state skips the baseline.
+	state, _ := json.Marshal(monitor.State)
+	resumed := &ChangelogMonitor{Client: client, URL: "https://example.com"}
+	json.Unmarshal(state, &resumed.State)
+	pages["https://example.com/changelog/2"] = "# 0.9.0\nBeta."
+	if events, _ := resumed.Check(ctx); len(events) != 1 || events[0].Version != "0.9.0" {
+		t.Errorf("resumed Check() = %+v, want the 0.9.0 entry", events)
+	}
+}
+
+func TestChangelogMonitorRun(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		content := "## v1.0.0\nFirst."
+		if calls.Add(1) > 1 {
+			content = "## v2.0.0\nSecond.\n\n## v1.0.0\nFirst."
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ExtractResponse{Results: []ExtractResult{{URL: "https://example.com/releases", RawContent: content}}})
+	}))
+	defer server.Close()
+
+	clock := tavilytest.NewFakeClock(time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC))
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: clock})
+	monitor := &ChangelogMonitor{Client: client, Pages: []string{"https://example.com/releases"}, Interval: 30 * time.Minute}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errStop := errors.New("stop")
+	got := make(chan ReleaseEvent, 1)
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.Run(ctx, ReleaseHandlerFunc(func(_ context.Context, e ReleaseEvent) error {
+			got <- e
+			return errStop
+		}))
+	}()
+
+	if err := clock.WaitForSleepers(ctx, 1); err != nil {
+		t.Fatalf("WaitForSleepers() error = %v", err)
+	}
+	clock.Advance(30 * time.Minute)
+
+	if e := <-got; e.Version != "v2.0.0" || e.Kind != ReleaseNew {
+		t.Errorf("Run() event = %+v, want the v2.0.0 release", e)
+	}
+	if err := <-done; !errors.Is(err, errStop) {
+		t.Errorf("Run() error = %v, want the handler error", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server calls = %d, want 2", got)
+	}
+}
+
+func TestChangelogMonitorRunStopsOnClose(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(ExtractResponse{Results: []ExtractResult{{URL: "https://example.com/releases", RawContent: "## v1.0.0"}}})
+	}))
+	defer server.Close()
+
+	clock := tavilytest.NewFakeClock(time.Date(2025, 5, 1, 9, 0, 0, 0, time.UTC))
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: clock})
+	monitor := &ChangelogMonitor{Client: client, Pages: []string{"https://example.com/releases"}}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- monitor.Run(context.Background(), ReleaseHandlerFunc(func(context.Context, ReleaseEvent) error { return nil }))
+	}()
+	if err := clock.WaitForSleepers(context.Background(), 1); err != nil {
+		t.Fatalf("WaitForSleepers() error = %v", err)
+	}
+
+	if err := client.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error = %v", err)
+	}
+	select {
+	case err := <-done:
+		if !errors.Is(err, ErrClientClosed) {
+			t.Errorf("Run() error = %v, want %v", err, ErrClientClosed)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Run() still running after Close")
+	}
+}
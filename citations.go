@@ -0,0 +1,96 @@
+package tavily
+
+import (
+	"fmt"
+	"strings"
+)
+
+// CitationStyle selects how Citations renders a source.
+type CitationStyle string
+
+const (
+	// CitationMarkdown renders "[1] [Title](url)".
+	CitationMarkdown CitationStyle = "markdown"
+	// CitationAPA renders an APA-like reference: "Site. (2024, May 3). Title. url".
+	CitationAPA CitationStyle = "apa"
+	// CitationFootnote renders a markdown footnote definition: "[^1]: [Title](url)".
+	CitationFootnote CitationStyle = "footnote"
+)
+
+// Citations numbers sources for reports built on search results. Sources are
+// deduplicated by canonical URL and keep the number they were first given, so
+// numbering stays stable as more results are added. The zero value is ready to use.
+type Citations struct {
+	sources []SearchResult
+	index   map[string]int
+}
+
+// Add registers results and returns their citation numbers, starting at 1.
+// Results already cited return their existing number.
+func (c *Citations) Add(results ...SearchResult) []int {
+	if c.index == nil {
+		c.index = make(map[string]int)
+	}
+	numbers := make([]int, len(results))
+	for i, r := range results {
+		key := normalizeResultURL(r.URL)
+		n, ok := c.index[key]
+		if !ok {
+			c.sources = append(c.sources, r)
+			n = len(c.sources)
+			c.index[key] = n
+		}
+		numbers[i] = n
+	}
+	return numbers
+}
+
+// Len returns the number of distinct sources.
+func (c *Citations) Len() int {
+	return len(c.sources)
+}
+
+// Format renders source n in style. It returns "" for unknown numbers.
+func (c *Citations) Format(n int, style CitationStyle) string {
+	if n < 1 || n > len(c.sources) {
+		return ""
+	}
+	r := c.sources[n-1]
+	title := citationTitle(r)
+
+	switch style {
+	case CitationAPA:
+		date := "n.d."
+		if t, ok := ParsePublishedDate(r.PublishedDate); ok {
+			date = t.Format("2006, January 2")
+		}
+		return fmt.Sprintf("%s. (%s). %s. %s", strings.TrimPrefix(domainOf(r.URL), "www."), date, strings.TrimSuffix(title, "."), r.URL)
+	case CitationFootnote:
+		return fmt.Sprintf("[^%d]: [%s](%s)", n, escapeLinkText(title), r.URL)
+	default:
+		return fmt.Sprintf("[%d] [%s](%s)", n, escapeLinkText(title), r.URL)
+	}
+}
+
+// List renders every source in style, one per line, in citation order.
+func (c *Citations) List(style CitationStyle) string {
+	var b strings.Builder
+	for n := 1; n <= len(c.sources); n++ {
+		b.WriteString(c.Format(n, style))
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+func citationTitle(r SearchResult) string {
+	if title := strings.TrimSpace(r.Title); title != "" {
+		return title
+	}
+	return r.URL
+}
+
+var linkTextEscaper = strings.NewReplacer("[", `\[`, "]", `\]`)
+
+func escapeLinkText(s string) string {
+	return linkTextEscaper.Replace(s)
+}
@@ -0,0 +1,37 @@
+package tavily
+
+import (
+	"testing"
+)
+
+func TestCitations(t *testing.T) {
+	var c Citations
+	numbers := c.Add(
+		SearchResult{Title: "Go 1.24 [release notes]", URL: "https://go.dev/doc/go1.24", PublishedDate: "2025-02-11"},
+		SearchResult{Title: "Generics tutorial.", URL: "https://www.go.dev/doc/tutorial/generics"},
+	)
+	again := c.Add(
+		SearchResult{Title: "Go 1.24", URL: "https://www.go.dev/doc/go1.24/"},
+		SearchResult{URL: "https://example.com/post"},
+	)
+	if numbers[0] != 1 || numbers[1] != 2 || again[0] != 1 || again[1] != 3 || c.Len() != 3 {
+		t.Fatalf("Add() numbers = %v then %v with Len() = %d, want [1 2] then [1 3] with 3", numbers, again, c.Len())
+	}
+
+	tests := []struct {
+		n     int
+		style CitationStyle
+		want  string
+	}{
+		{n: 1, style: CitationMarkdown, want: `[1] [Go 1.24 \[release notes\]](https://go.dev/doc/go1.24)`},
+		{n: 1, style: CitationAPA, want: "go.dev. (2025, February 11). Go 1.24 [release notes]. https://go.dev/doc/go1.24"},
+		{n: 2, style: CitationAPA, want: "go.dev. (n.d.). Generics tutorial. https://www.go.dev/doc/tutorial/generics"},
+		{n: 3, style: CitationFootnote, want: "[^3]: [https://example.com/post](https://example.com/post)"},
+		{n: 4, style: CitationMarkdown, want: ""},
+	}
+	for _, tt := range tests {
+		if got := c.Format(tt.n, tt.style); got != tt.want {
+			t.Errorf("Format(%d, %s) = %q, want %q", tt.n, tt.style, got, tt.want)
+		}
+	}
+}
@@ -22,8 +22,12 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
+	"maps"
 	"net/http"
+	"net/url"
 	"os"
+	"regexp"
 	"strings"
 	"time"
 )
@@ -33,22 +37,131 @@ const (
 	DefaultTimeout     = 60 * time.Second
 	DefaultMaxResults  = 5
 	DefaultSearchDepth = "basic"
-	DefaultTopic       = "general"
-	DefaultFormat      = "text"
-	ClientSource       = "go-tavily"
+	// DefaultExtractDepth is the extract and crawl depth used when neither the
+	// call nor the Plan chooses one.
+	DefaultExtractDepth = "basic"
+	DefaultTopic        = "general"
+	DefaultFormat       = "text"
+	ClientSource        = "go-tavily"
 )
 
 type Client struct {
 	baseURL    string
+	endpoints  *endpointPool
 	apiKey     string
 	httpClient *http.Client
 	headers    map[string]string
+	limiter    *domainLimiter
+	pages      PageStore
+	logger     *slog.Logger
+	redactor   *Redactor
+	debug      bool
+	retry      *RetryPolicy
+	lifecycle  *lifecycle
+	cache      Cache
+	cacheTTL   time.Duration
+	topicTTL   map[Topic]time.Duration
+	cacheFold  bool
+	offline    bool
+	validate   bool
+	quota      *QuotaManager
+	answers    *AnswerCache
+	maps       *MapMemo
+	filter     ContentFilter
+	panics     PanicPolicy
+	rate       *Limiter
+	crawlRate  *Limiter
+	plan       Plan
+	rateLimits *rateLimitTracker
+	pacer      *autoPacer
+	clock      Clock
+	version    APIVersion
+	keepRaw    bool
+	rewriter   QueryRewriter
+	pii        *PIIScrubber
+	auditSink  AuditSink
 }
 
 type Options struct {
-	BaseURL    string
+	BaseURL string
+	// FallbackBaseURLs are tried in order while BaseURL is unreachable or
+	// consistently returning server errors.
+	FallbackBaseURLs []string
+	// Failover tunes endpoint health tracking. Nil uses the defaults.
+	Failover *FailoverPolicy
+
 	HTTPClient *http.Client
 	Timeout    time.Duration
+	Politeness *PolitenessOptions
+	PageStore  PageStore
+
+	// Logger receives request logs at debug level. Every record is passed through
+	// a Redactor, so API keys and RedactPatterns matches never reach the handler.
+	Logger *slog.Logger
+	// Debug additionally logs request and response bodies, falling back to
+	// slog.Default when Logger is nil.
+	Debug bool
+	// RedactPatterns lists extra sensitive patterns masked in logs and errors.
+	RedactPatterns []*regexp.Regexp
+	// Retry configures retries of failed requests. Nil uses the default
+	// exponential policy with DefaultMaxAttempts attempts.
+	Retry *RetryPolicy
+	// Cache stores successful responses and serves repeated requests from them.
+	Cache Cache
+	// CacheTTL bounds how long cached responses are served. Zero means no expiry.
+	CacheTTL time.Duration
+	// TopicTTL overrides CacheTTL for searches by topic, so news and finance
+	// results expire sooner than general ones, e.g. DefaultTopicTTLs().
+	// Topics without an entry use CacheTTL.
+	TopicTTL map[Topic]time.Duration
+	// CacheFoldQueryCase makes cache lookups ignore the case of search queries.
+	CacheFoldQueryCase bool
+	// Offline answers exclusively from Cache without credentials or network
+	// access; requests without a cached response fail with ErrCacheMiss.
+	Offline bool
+	// ValidateResponses checks every response against the documented schema and
+	// reports violations in ResponseMeta.SchemaDrift.
+	ValidateResponses bool
+	// Quota enforces per-tenant limits on calls made with WithTenant.
+	Quota *QuotaManager
+	// AnswerCache memoizes Answer results by normalized question.
+	AnswerCache *AnswerCache
+	// MapMemo reuses Map results per site for its TTL.
+	MapMemo *MapMemo
+	// ContentFilter withholds search results and answers it blocks.
+	ContentFilter ContentFilter
+	// PanicPolicy decides whether a panicking hook fails the call or is skipped.
+	PanicPolicy PanicPolicy
+	// Limiter paces API requests; share one between clients using the same key.
+	Limiter *Limiter
+	// Plan applies the limits of the key's plan, e.g. PlanForKey(apiKey).
+	Plan Plan
+	// AutoPace adapts the request rate to 429s and rate limit headers.
+	AutoPace *AutoPace
+	// Clock drives retry backoff, the pacing of Politeness, AutoPace and
+	// plan-derived limiters, failover cooldowns, Since/Until conversion and
+	// response timestamps. Nil uses SystemClock.
+	Clock Clock
+	// Redirects limits and observes the redirects followed by the HTTP client
+	// New builds. It is ignored when HTTPClient is set.
+	Redirects *RedirectPolicy
+	// APIVersion routes and encodes requests for a revision of the API.
+	// The zero value is APIVersion1.
+	APIVersion APIVersion
+	// KeepRawResponses keeps each response body, available from the
+	// response's Raw method, at the cost of holding it in memory.
+	KeepRawResponses bool
+	// QueryRewriter rewrites search queries before they are sent, e.g.
+	// NormalizeQuery. Applied rewrites are recorded in ResponseMeta.QueryRewrite.
+	QueryRewriter QueryRewriter
+	// PIIScrubber replaces emails, phone numbers, card numbers and other
+	// personal data in the answer and in result titles and content. Bodies
+	// are scrubbed as soon as they are received, so the Cache, bodies kept by
+	// KeepRawResponses and SearchInto only ever see scrubbed text.
+	PIIScrubber *PIIScrubber
+	// AuditSink records every query and URL retrieved, who initiated it and a
+	// digest of the result, for compliance logging. See AuditRecord.
+	AuditSink AuditSink
 }
 
 // New creates a new Tavily API client with the provided API key.
@@ -72,6 +185,11 @@ func New(apiKey string, opts *Options) *Client {
 		timeout = DefaultTimeout
 	}
 
+	pages := opts.PageStore
+	if pages == nil {
+		pages = &MemoryPageStore{}
+	}
+
 	httpClient := opts.HTTPClient
 	if httpClient == nil {
 		httpClient = &http.Client{
@@ -79,8 +197,35 @@ func New(apiKey string, opts *Options) *Client {
 		}
 	}
 
-	return &Client{
-		baseURL:    strings.TrimSuffix(baseURL, "/"),
+	cache := opts.Cache
+	if cache == nil && opts.Offline {
+		cache = &MemoryCache{}
+	}
+
+	baseURLs := []string{strings.TrimSuffix(baseURL, "/")}
+	for _, u := range opts.FallbackBaseURLs {
+		baseURLs = append(baseURLs, strings.TrimSuffix(u, "/"))
+	}
+
+	redactor := NewRedactor([]string{apiKey}, opts.RedactPatterns...)
+
+	version := opts.APIVersion
+	if version.Name == "" {
+		version = APIVersion1
+	}
+
+	rate := opts.Limiter
+	if rate == nil && opts.Plan.RequestsPerMinute > 0 {
+		rate = &Limiter{RequestsPerMinute: opts.Plan.RequestsPerMinute, Clock: opts.Clock}
+	}
+	var crawlRate *Limiter
+	if opts.Plan.CrawlRequestsPerMinute > 0 {
+		crawlRate = &Limiter{RequestsPerMinute: opts.Plan.CrawlRequestsPerMinute, Clock: opts.Clock}
+	}
+
+	c := &Client{
+		baseURL:    baseURLs[0],
+		endpoints:  newEndpointPool(baseURLs, opts.Failover, opts.Clock),
 		apiKey:     apiKey,
 		httpClient: httpClient,
 		headers: map[string]string{
@@ -88,69 +233,326 @@ func New(apiKey string, opts *Options) *Client {
 			"Authorization":   "Bearer " + apiKey,
 			"X-Client-Source": ClientSource,
 		},
-	}
+		limiter:    newDomainLimiter(opts.Politeness, opts.Clock),
+		pages:      pages,
+		logger:     newLogger(opts, redactor),
+		redactor:   redactor,
+		debug:      opts.Debug,
+		retry:      opts.Retry,
+		lifecycle:  newLifecycle(),
+		cache:      cache,
+		cacheTTL:   opts.CacheTTL,
+		topicTTL:   maps.Clone(opts.TopicTTL),
+		cacheFold:  opts.CacheFoldQueryCase,
+		offline:    opts.Offline,
+		validate:   opts.ValidateResponses,
+		quota:      opts.Quota,
+		answers:    opts.AnswerCache,
+		maps:       opts.MapMemo,
+		filter:     opts.ContentFilter,
+		panics:     opts.PanicPolicy,
+		rate:       rate,
+		crawlRate:  crawlRate,
+		plan:       opts.Plan,
+		rateLimits: &rateLimitTracker{clock: clockOr(opts.Clock)},
+		pacer:      newAutoPacer(opts.AutoPace, opts.Clock),
+		clock:      clockOr(opts.Clock),
+		version:    version,
+		keepRaw:    opts.KeepRawResponses,
+		rewriter:   opts.QueryRewriter,
+		pii:        opts.PIIScrubber,
+		auditSink:  opts.AuditSink,
+	}
+	if opts.HTTPClient == nil && opts.Redirects != nil {
+		httpClient.CheckRedirect = c.checkRedirect(opts.Redirects)
+	}
+	return c
 }
 
-func (c *Client) doRequest(ctx context.Context, endpoint string, requestBody any, responseBody any) error {
-	if c.apiKey == "" {
+// doRequest sends requestBody to endpoint with method and decodes the reply
+// into responseBody. Methods without a body (see bodyless) carry the request
+// as a query string instead, encoded with QueryValues.
+func (c *Client) doRequest(ctx context.Context, method, endpoint string, requestBody any, responseBody any) error {
+	if c.apiKey == "" && !c.offline {
 		return &APIError{
 			StatusCode: 401,
 			Message:    "missing API key - provide via parameter or TAVILY_API_KEY environment variable",
 		}
 	}
 
-	var body io.Reader
+	if err := c.plan.check(endpoint, requestBody); err != nil {
+		return err
+	}
+	if err := c.version.check(endpoint, requestBody); err != nil {
+		return err
+	}
+
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	ctx, requestID := ensureRequestID(ctx)
+
+	var jsonData []byte
 	if requestBody != nil {
-		jsonData, err := json.Marshal(requestBody)
+		jsonData, err = json.Marshal(requestBody)
 		if err != nil {
 			return fmt.Errorf("failed to marshal request: %w", err)
 		}
+		if c.debug {
+			c.logDebug(ctx, "tavily request body", "endpoint", endpoint, "request_id", requestID, "body", string(jsonData))
+		}
+	}
+
+	// path and body are what is sent on the wire: routed and encoded for the
+	// API version, with the payload in the query string for bodyless methods.
+	// jsonData still keys the cache either way.
+	path := c.version.PathPrefix + endpoint
+	body, err := c.version.encode(jsonData)
+	if err != nil {
+		return fmt.Errorf("failed to encode request: %w", err)
+	}
+	if bodyless(method) {
+		var query url.Values
+		query, err = QueryValues(json.RawMessage(body))
+		if err != nil {
+			return fmt.Errorf("failed to encode query: %w", err)
+		}
+		if len(query) > 0 {
+			path += "?" + query.Encode()
+		}
+		body = nil
+	}
+
+	start := c.clock.Now()
+
+	var key string
+	var respData []byte
+	var cached bool
+	if c.cache != nil {
+		keyEndpoint := c.version.PathPrefix + endpoint
+		if method != http.MethodPost {
+			keyEndpoint += " " + method
+		}
+		key = cacheKey(keyEndpoint, jsonData, c.cacheFold)
+		if err := c.runHook(ctx, "Cache", func() { respData, cached = c.cache.Get(key) }); err != nil && c.abortOnPanic() {
+			return err
+		}
+	}
+
+	attempts := 0
+	var tenant string
+	if !cached {
+		if c.offline {
+			return fmt.Errorf("%w for %s", ErrCacheMiss, endpoint)
+		}
+		if c.quota != nil {
+			if tenant, err = c.quota.reserve(ctx); err != nil {
+				return err
+			}
+		}
+		respData, attempts, err = c.send(ctx, method, endpoint, path, requestID, requestBody, body)
+		if err != nil {
+			if c.auditSink != nil {
+				record := c.newAuditRecord(ctx, method, endpoint, requestID, requestBody, jsonData)
+				record.Attempts = attempts
+				return c.audit(ctx, record, nil, err)
+			}
+			return err
+		}
+	}
+
+	// Scrub before the body is cached, audited or kept for Raw, so personal
+	// data never leaves this call. Cache hits are scrubbed again in case the
+	// cache is shared with a client without a scrubber.
+	respData, redactions, err := c.scrubResponseBody(ctx, respData)
+	if err != nil {
+		return err
+	}
+	if !cached && c.cache != nil {
+		if err := c.runHook(ctx, "Cache", func() { c.cache.Set(key, respData, c.cacheTTLFor(requestBody)) }); err != nil && c.abortOnPanic() {
+			return err
+		}
+	}
+
+	if c.auditSink != nil {
+		record := c.newAuditRecord(ctx, method, endpoint, requestID, requestBody, jsonData)
+		record.Attempts, record.CacheHit = attempts, cached
+		if err := c.audit(ctx, record, respData, nil); err != nil {
+			return err
+		}
+	}
+
+	if responseBody != nil {
+		if err := json.Unmarshal(respData, responseBody); err != nil {
+			return fmt.Errorf("failed to unmarshal response: %w", err)
+		}
+	}
+
+	if m, ok := responseBody.(metaCarrier); ok {
+		meta := ResponseMeta{
+			RequestID:        requestID,
+			RetrievedAt:      c.clock.Now(),
+			ElapsedWallClock: c.clock.Now().Sub(start),
+			Attempts:         attempts,
+			CacheHit:         cached,
+			PIIRedactions:    redactions,
+		}
+		if c.keepRawFor(ctx) {
+			meta.raw = respData
+		}
+		if !cached {
+			meta.EstimatedCredits = estimateCredits(requestBody, responseBody)
+			if c.quota != nil {
+				c.quota.record(tenant, meta.EstimatedCredits)
+			}
+		}
+		if v, ok := responseBody.(schemaValidator); ok && c.validate {
+			meta.SchemaDrift = v.Validate()
+			for _, drift := range meta.SchemaDrift {
+				c.logDebug(ctx, "tavily response schema drift",
+					"endpoint", endpoint, "request_id", requestID, "drift", drift.String())
+			}
+		}
+		*m.responseMeta() = meta
+	}
+
+	return nil
+}
+
+// send performs the request, retrying per the client's RetryPolicy and failing
+// over between endpoints, and returns the response body along with the number
+// of attempts made. Failovers do not count against the RetryPolicy.
+func (c *Client) send(ctx context.Context, method, endpoint, path, requestID string, requestBody any, body []byte) ([]byte, int, error) {
+	failovers := 0
+	for attempt := 1; ; attempt++ {
+		if err := c.rate.Wait(ctx); err != nil {
+			return nil, attempt - 1, err
+		}
+		if endpoint == "/crawl" {
+			if err := c.crawlRate.Wait(ctx); err != nil {
+				return nil, attempt - 1, err
+			}
+		}
+		if err := c.pacer.wait(ctx); err != nil {
+			return nil, attempt - 1, err
+		}
+		baseURL := c.baseURLFor(ctx)
+		resp, respData, err := c.doAttempt(ctx, method, baseURL, path, requestID, body)
+		c.pacer.observe(resp, c.rateLimits.get())
+		down := c.endpoints.report(ctx, baseURL, resp, err)
+		if err == nil {
+			return respData, attempt, nil
+		}
+
+		// Switch to the next endpoint right away instead of backing off, at
+		// most once per configured endpoint.
+		if next := c.baseURLFor(ctx); down && next != baseURL && failovers < len(c.endpoints.endpoints) {
+			failovers++
+			c.logDebug(ctx, "tavily endpoint failover",
+				"endpoint", endpoint, "request_id", requestID, "from", baseURL, "to", next, "error", err)
+			continue
+		}
+
+		var delay time.Duration
+		var retry bool
+		if perr := c.runHook(ctx, "RetryPolicy.Decide", func() {
+			delay, retry = c.retry.decide(RetryAttempt{
+				Time:     c.clock.Now(),
+				Attempt:  attempt - failovers,
+				Endpoint: endpoint,
+				Request:  requestBody,
+				Err:      err,
+				Response: resp,
+			})
+		}); perr != nil && c.abortOnPanic() {
+			return nil, attempt, perr
+		}
+		if !retry {
+			return nil, attempt, err
+		}
+
+		c.logDebug(ctx, "tavily request retrying",
+			"endpoint", endpoint, "request_id", requestID, "attempt", attempt, "delay", delay, "error", err)
+		if sleepErr := c.clock.Sleep(ctx, delay); sleepErr != nil {
+			return nil, attempt, err
+		}
+	}
+}
+
+// doAttempt performs a single HTTP round trip. The response is returned alongside
+// any error so retry decisions can inspect its status and headers.
+func (c *Client) doAttempt(ctx context.Context, method, baseURL, endpoint, requestID string, jsonData []byte) (*http.Response, []byte, error) {
+	var body io.Reader
+	if jsonData != nil {
 		body = bytes.NewReader(jsonData)
 	}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.baseURL+endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, method, baseURL+endpoint, body)
 	if err != nil {
-		return fmt.Errorf("failed to create request: %w", err)
+		return nil, nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
 	for key, value := range c.headers {
 		req.Header.Set(key, value)
 	}
+	req.Header.Set(RequestIDHeader, requestID)
 
+	start := c.clock.Now()
 	resp, err := c.httpClient.Do(req)
 	if err != nil {
-		return fmt.Errorf("request failed: %w", err)
+		c.logDebug(ctx, "tavily request failed", "endpoint", endpoint, "request_id", requestID, "error", err)
+		return nil, nil, fmt.Errorf("request failed: %w", err)
 	}
 	defer resp.Body.Close()
+	c.rateLimits.observe(resp)
 
 	respData, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return fmt.Errorf("failed to read response: %w", err)
+		return resp, nil, fmt.Errorf("failed to read response: %w", err)
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return parseAPIError(resp.StatusCode, respData)
+	c.logDebug(ctx, "tavily request completed",
+		"endpoint", endpoint, "request_id", requestID, "status", resp.StatusCode, "duration", c.clock.Now().Sub(start))
+	if c.debug {
+		c.logDebug(ctx, "tavily response body", "endpoint", endpoint, "request_id", requestID, "body", string(respData))
 	}
 
-	if responseBody != nil {
-		if err := json.Unmarshal(respData, responseBody); err != nil {
-			return fmt.Errorf("failed to unmarshal response: %w", err)
+	if resp.StatusCode != http.StatusOK {
+		apiErr := parseAPIError(resp.StatusCode, respData)
+		apiErr.Message = c.redactor.Redact(apiErr.Message)
+		if apiErr.IsPlanLimitExceeded() && c.plan.Name != "" {
+			apiErr.Message += fmt.Sprintf(" (usage limit of the %s plan reached)", c.plan.Name)
 		}
+		apiErr.RequestID = requestID
+		return resp, respData, apiErr
 	}
 
-	return nil
+	return resp, respData, nil
 }
 
-func parseAPIError(statusCode int, respData []byte) error {
-	var errorResp struct {
-		Detail struct {
-			Error string `json:"error"`
-		} `json:"detail"`
+// maxErrorBody bounds how much of an unrecognized error body ends up in APIError.Message.
+const maxErrorBody = 512
+
+// parseAPIError extracts the message from any of the error shapes the API
+// uses: {"detail": {"error": ...}}, {"detail": "..."}, validation error lists
+// under "detail", {"error": ...} and {"message": ...}. Other bodies, such as
+// plain text from a proxy, are kept verbatim (truncated) so the cause is not lost.
+func parseAPIError(statusCode int, respData []byte) *APIError {
+	message := errorMessage(respData)
+	if message == "" {
+		message = strings.TrimSpace(string(respData))
+		if len(message) > maxErrorBody {
+			message = strings.ToValidUTF8(message[:maxErrorBody], "") + "..."
+		}
 	}
-
-	message := "unknown error"
-	if json.Unmarshal(respData, &errorResp) == nil && errorResp.Detail.Error != "" {
-		message = errorResp.Detail.Error
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+	if message == "" {
+		message = "unknown error"
 	}
 
 	return &APIError{
@@ -159,37 +561,140 @@ func parseAPIError(statusCode int, respData []byte) error {
 	}
 }
 
+// errorMessage returns the message in a JSON error value, or "" if it has none.
+func errorMessage(data []byte) string {
+	var text string
+	if json.Unmarshal(data, &text) == nil {
+		return strings.TrimSpace(text)
+	}
+
+	var list []json.RawMessage
+	if json.Unmarshal(data, &list) == nil {
+		var messages []string
+		for _, item := range list {
+			if m := errorMessage(item); m != "" {
+				messages = append(messages, m)
+			}
+		}
+		return strings.Join(messages, "; ")
+	}
+
+	var obj map[string]json.RawMessage
+	if json.Unmarshal(data, &obj) != nil {
+		return ""
+	}
+	for _, key := range []string{"detail", "error", "message", "msg"} {
+		if m := errorMessage(obj[key]); m != "" {
+			if field := errorLocation(obj["loc"]); field != "" {
+				return field + ": " + m
+			}
+			return m
+		}
+	}
+	return ""
+}
+
+// errorLocation renders the "loc" path of a validation error, skipping the
+// leading "body" element.
+func errorLocation(data []byte) string {
+	var loc []any
+	if json.Unmarshal(data, &loc) != nil {
+		return ""
+	}
+	var parts []string
+	for i, p := range loc {
+		if i == 0 && p == "body" {
+			continue
+		}
+		parts = append(parts, fmt.Sprint(p))
+	}
+	return strings.Join(parts, ".")
+}
+
 // Search performs an intelligent web search with advanced filtering and content aggregation.
 func (c *Client) Search(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	opts = withDefaults(opts, callOptionsFrom(ctx).Search)
 	if opts == nil {
 		opts = &SearchOptions{}
 	}
 
+	depth := defaultString(opts.SearchDepth, c.plan.defaultSearchDepth())
+	now := c.clock.Now()
+	if err := validateSearchOptions(opts, depth, now); err != nil {
+		return nil, err
+	}
+
+	ctx, _ = ensureRequestID(ctx)
+
+	query, rewrite, err := c.rewriteQuery(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	// Validated above, so the conversion cannot fail.
+	timeRange, days, _ := resolveTimeWindow(opts, now)
+
 	req := &SearchRequest{
 		Query:                    query,
-		SearchDepth:              defaultString(opts.SearchDepth, DefaultSearchDepth),
+		SearchDepth:              depth,
 		Topic:                    defaultString(opts.Topic, DefaultTopic),
-		TimeRange:                opts.TimeRange,
-		Days:                     opts.Days,
-		MaxResults:               defaultInt(opts.MaxResults, DefaultMaxResults),
+		TimeRange:                timeRange,
+		Days:                     optionalInt(days),
+		MaxResults:               resolveInt(opts.MaxResults, DefaultMaxResults),
 		IncludeDomains:           opts.IncludeDomains,
 		ExcludeDomains:           opts.ExcludeDomains,
 		IncludeAnswer:            opts.IncludeAnswer,
 		IncludeRawContent:        opts.IncludeRawContent,
-		IncludeImages:            opts.IncludeImages,
+		IncludeImages:            includeImages(opts),
 		IncludeImageDescriptions: opts.IncludeImageDescriptions,
 		MaxTokens:                opts.MaxTokens,
 		ChunksPerSource:          opts.ChunksPerSource,
 		Country:                  opts.Country,
-		Timeout:                  defaultInt(opts.Timeout, 60),
+		Timeout:                  resolveInt(opts.Timeout, 60),
+	}
+
+	resp, err := Call[*SearchRequest, SearchResponse](ctx, c, "/search", req)
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
 	}
+	resp.Meta.QueryRewrite = rewrite
 
-	var resp SearchResponse
-	if err := c.doRequest(ctx, "/search", req, &resp); err != nil {
+	if err := reconcileRawContent(resp.Results, requestedRawFormat(opts.IncludeRawContent), opts.RawContentPolicy); err != nil {
 		return nil, fmt.Errorf("search failed: %w", err)
 	}
 
-	return &resp, nil
+	if filter := newResultFilter(opts); filter.active() {
+		var scorer *guardedScorer
+		if filter.scorer != nil {
+			scorer = &guardedScorer{c: c, ctx: ctx, scorer: filter.scorer}
+			filter.scorer = scorer
+		}
+		if err := c.filterResults(ctx, req, resp, filter); err != nil {
+			return nil, fmt.Errorf("search failed: %w", err)
+		}
+		if scorer != nil && scorer.err != nil {
+			return nil, fmt.Errorf("search failed: %w", scorer.err)
+		}
+	}
+
+	if c.filter != nil {
+		filter := &guardedFilter{c: c, ctx: ctx, filter: c.filter}
+		applyContentFilter(resp, filter)
+		if filter.err != nil {
+			return nil, fmt.Errorf("search failed: %w", filter.err)
+		}
+	}
+
+	switch {
+	case opts.Enrich != nil:
+		c.EnrichResults(ctx, resp.Results, opts.Enrich)
+	case opts.VerifyLinks != nil:
+		c.EnrichResults(ctx, resp.Results, opts.VerifyLinks)
+		resp.Results = pruneDeadLinks(resp.Results)
+	}
+	attachProvenance(resp, query)
+
+	return resp, nil
 }
 
 // Extract extracts and processes content from one or more specified URLs.
@@ -201,24 +706,81 @@ func (c *Client) Extract(ctx context.Context, urls []string, opts *ExtractOption
 		}
 	}
 
+	opts = withDefaults(opts, callOptionsFrom(ctx).Extract)
 	if opts == nil {
 		opts = &ExtractOptions{}
 	}
 
+	if err := validateExtractDepth(opts.ExtractDepth); err != nil {
+		return nil, err
+	}
+
+	var redirects map[string]string
+	if opts.ResolveRedirects {
+		urls, redirects = c.resolveRedirects(ctx, urls)
+	}
+
+	var skipped []ExtractFailedResult
+	if opts.Binary != BinarySend {
+		urls, skipped = partitionBinary(urls)
+		if len(skipped) > 0 && opts.Binary == BinaryFail {
+			return nil, &APIError{
+				StatusCode: 400,
+				Message:    fmt.Sprintf("%d URLs point to binary content, e.g. %s", len(skipped), skipped[0].URL),
+			}
+		}
+		if len(urls) == 0 {
+			return &ExtractResponse{FailedResults: skipped, Redirects: redirects}, nil
+		}
+	}
+
+	var unhealthy []ExtractFailedResult
+	if opts.HealthCheck != nil {
+		urls, unhealthy = c.CheckURLHealth(ctx, urls, opts.HealthCheck)
+		if len(urls) == 0 {
+			return &ExtractResponse{FailedResults: skipped, Redirects: redirects, Unhealthy: unhealthy}, nil
+		}
+	}
+
 	req := &ExtractRequest{
 		URLs:          urls,
 		IncludeImages: opts.IncludeImages,
-		ExtractDepth:  defaultString(opts.ExtractDepth, DefaultSearchDepth),
+		ExtractDepth:  defaultString(opts.ExtractDepth, c.plan.defaultExtractDepth()),
 		Format:        defaultString(opts.Format, DefaultFormat),
-		Timeout:       defaultInt(opts.Timeout, 60),
+		Timeout:       resolveInt(opts.Timeout, 60),
 	}
 
-	var resp ExtractResponse
-	if err := c.doRequest(ctx, "/extract", req, &resp); err != nil {
+	release, err := c.limiter.acquire(ctx, urls...)
+	if err != nil {
 		return nil, fmt.Errorf("extract failed: %w", err)
 	}
+	resp, err := Call[*ExtractRequest, ExtractResponse](ctx, c, "/extract", req)
+	// The local fallback below takes its own slots for the same hosts.
+	release()
+	if err != nil {
+		return nil, fmt.Errorf("extract failed: %w", err)
+	}
+
+	if opts.LocalFallback != nil {
+		if err := c.extractLocally(ctx, resp, opts.LocalFallback); err != nil {
+			return nil, fmt.Errorf("extract failed: %w", err)
+		}
+	}
+	// API content was scrubbed with the response body; local content was not.
+	for i := range resp.Results {
+		if !resp.Results[i].LocalFallback {
+			continue
+		}
+		if err := c.scrubPII(ctx, &resp.Meta, &resp.Results[i].RawContent); err != nil {
+			return nil, fmt.Errorf("extract failed: %w", err)
+		}
+	}
+	resp.FailedResults = append(resp.FailedResults, skipped...)
+	resp.Redirects = redirects
+	resp.Unhealthy = unhealthy
+	classifyFailures(resp.FailedResults)
 
-	return &resp, nil
+	return resp, nil
 }
 
 // Crawl intelligently crawls a website to discover and extract content from multiple pages.
@@ -230,17 +792,25 @@ func (c *Client) Crawl(ctx context.Context, url string, opts *CrawlOptions) (*Cr
 		}
 	}
 
+	opts = withDefaults(opts, callOptionsFrom(ctx).Crawl)
 	if opts == nil {
 		opts = &CrawlOptions{}
 	}
 
+	if err := validateCategories(opts.Categories); err != nil {
+		return nil, err
+	}
+	if err := validateExtractDepth(opts.ExtractDepth); err != nil {
+		return nil, err
+	}
+
 	req := &CrawlRequest{
 		URL:            url,
-		MaxDepth:       defaultInt(opts.MaxDepth, 1),
-		MaxBreadth:     defaultInt(opts.MaxBreadth, 20),
-		Limit:          defaultInt(opts.Limit, 50),
+		MaxDepth:       resolveInt(opts.MaxDepth, 1),
+		MaxBreadth:     resolveInt(opts.MaxBreadth, 20),
+		Limit:          resolveInt(opts.Limit, 50),
 		Instructions:   opts.Instructions,
-		ExtractDepth:   defaultString(opts.ExtractDepth, DefaultSearchDepth),
+		ExtractDepth:   defaultString(opts.ExtractDepth, c.plan.defaultExtractDepth()),
 		SelectPaths:    opts.SelectPaths,
 		SelectDomains:  opts.SelectDomains,
 		ExcludePaths:   opts.ExcludePaths,
@@ -249,15 +819,34 @@ func (c *Client) Crawl(ctx context.Context, url string, opts *CrawlOptions) (*Cr
 		IncludeImages:  opts.IncludeImages,
 		Categories:     opts.Categories,
 		Format:         defaultString(opts.Format, DefaultFormat),
-		Timeout:        defaultInt(opts.Timeout, 60),
+		Timeout:        resolveInt(opts.Timeout, 60),
 	}
 
-	var resp CrawlResponse
-	if err := c.doRequest(ctx, "/crawl", req, &resp); err != nil {
+	var warnings []string
+	if opts.Preflight != PreflightOff {
+		report, err := c.Preflight(ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("crawl failed: %w", err)
+		}
+		if !report.OK() && opts.Preflight == PreflightFail {
+			return nil, fmt.Errorf("crawl failed: %w", &PreflightError{Report: report})
+		}
+		warnings = report.Warnings
+	}
+
+	release, err := c.limiter.acquire(ctx, url)
+	if err != nil {
 		return nil, fmt.Errorf("crawl failed: %w", err)
 	}
+	defer release()
 
-	return &resp, nil
+	resp, err := Call[*CrawlRequest, CrawlResponse](ctx, c, "/crawl", req)
+	if err != nil {
+		return nil, fmt.Errorf("crawl failed: %w", err)
+	}
+	resp.PreflightWarnings = warnings
+
+	return resp, nil
 }
 
 // Map discovers and maps the structure of a website without extracting full content.
@@ -269,15 +858,20 @@ func (c *Client) Map(ctx context.Context, url string, opts *MapOptions) (*MapRes
 		}
 	}
 
+	opts = withDefaults(opts, callOptionsFrom(ctx).Map)
 	if opts == nil {
 		opts = &MapOptions{}
 	}
 
+	if err := validateCategories(opts.Categories); err != nil {
+		return nil, err
+	}
+
 	req := &MapRequest{
 		URL:            url,
-		MaxDepth:       defaultInt(opts.MaxDepth, 1),
-		MaxBreadth:     defaultInt(opts.MaxBreadth, 20),
-		Limit:          defaultInt(opts.Limit, 50),
+		MaxDepth:       resolveInt(opts.MaxDepth, 1),
+		MaxBreadth:     resolveInt(opts.MaxBreadth, 20),
+		Limit:          resolveInt(opts.Limit, 50),
 		Instructions:   opts.Instructions,
 		SelectPaths:    opts.SelectPaths,
 		SelectDomains:  opts.SelectDomains,
@@ -285,15 +879,65 @@ func (c *Client) Map(ctx context.Context, url string, opts *MapOptions) (*MapRes
 		ExcludeDomains: opts.ExcludeDomains,
 		AllowExternal:  opts.AllowExternal,
 		Categories:     opts.Categories,
-		Timeout:        defaultInt(opts.Timeout, 60),
+		Timeout:        resolveInt(opts.Timeout, 60),
+	}
+
+	mapSite := func(ctx context.Context) (*MapResponse, error) {
+		release, err := c.limiter.acquire(ctx, url)
+		if err != nil {
+			return nil, fmt.Errorf("map failed: %w", err)
+		}
+		defer release()
+
+		resp, err := Call[*MapRequest, MapResponse](ctx, c, "/map", req)
+		if err != nil {
+			return nil, fmt.Errorf("map failed: %w", err)
+		}
+		return resp, nil
+	}
+
+	if c.maps == nil {
+		return mapSite(ctx)
+	}
+	return c.maps.do(ctx, req, mapSite)
+}
+
+// validateExtractDepth rejects extract depths the API does not accept, such
+// as search-only depths, before any credits are spent.
+func validateExtractDepth(depth string) error {
+	if depth == "" || ExtractDepth(depth).IsValid() {
+		return nil
+	}
+	valid := make([]string, len(extractDepths))
+	for i, d := range extractDepths {
+		valid[i] = string(d)
 	}
+	return &APIError{
+		StatusCode: 400,
+		Message:    fmt.Sprintf("unknown ExtractDepth %q (valid: %s)", depth, strings.Join(valid, ", ")),
+	}
+}
 
-	var resp MapResponse
-	if err := c.doRequest(ctx, "/map", req, &resp); err != nil {
-		return nil, fmt.Errorf("map failed: %w", err)
+func validateCategories(categories []CrawlCategory) error {
+	var invalid []string
+	for _, c := range categories {
+		if !c.IsValid() {
+			invalid = append(invalid, fmt.Sprintf("%q", c))
+		}
+	}
+	if len(invalid) == 0 {
+		return nil
 	}
 
-	return &resp, nil
+	valid := make([]string, len(crawlCategories))
+	for i, c := range crawlCategories {
+		valid[i] = string(c)
+	}
+	return &APIError{
+		StatusCode: 400,
+		Message: fmt.Sprintf("unknown crawl categories %s (valid: %s)",
+			strings.Join(invalid, ", "), strings.Join(valid, ", ")),
+	}
 }
 
 func defaultString(value, defaultValue string) string {
@@ -2,12 +2,19 @@ package tavily
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
 )
 
 func TestNew(t *testing.T) {
@@ -80,6 +87,30 @@ func TestAPIError(t *testing.T) {
 			message:    "Access denied",
 			checkFunc:  (*APIError).IsForbidden,
 		},
+		{
+			name:       "plan limit error",
+			statusCode: 432,
+			message:    "This request exceeds your plan's set usage limit.",
+			checkFunc:  (*APIError).IsPlanLimitExceeded,
+		},
+		{
+			name:       "pay-as-you-go limit error",
+			statusCode: 433,
+			message:    "This request exceeds the pay-as-you-go limit.",
+			checkFunc:  (*APIError).IsPayAsYouGoLimitExceeded,
+		},
+		{
+			name:       "disabled key error",
+			statusCode: 403,
+			message:    "API key has been disabled",
+			checkFunc:  (*APIError).IsKeyDisabled,
+		},
+		{
+			name:       "access denied error",
+			statusCode: 403,
+			message:    "Access denied",
+			checkFunc:  (*APIError).IsAccessDenied,
+		},
 		{
 			name:       "bad request error",
 			statusCode: 400,
@@ -106,6 +137,67 @@ func TestAPIError(t *testing.T) {
 	}
 }
 
+func TestParseAPIError(t *testing.T) {
+	tests := []struct {
+		name string
+		body string
+		want string
+	}{
+		{"detail error", `{"detail": {"error": "Invalid API key"}}`, "Invalid API key"},
+		{"detail string", `{"detail": "Not authenticated"}`, "Not authenticated"},
+		{"validation list", `{"detail": [{"loc": ["body", "max_results"], "msg": "must be <= 20"}, {"loc": ["body", "query"], "msg": "field required"}]}`,
+			"max_results: must be <= 20; query: field required"},
+		{"error string", `{"error": "Rate limit exceeded"}`, "Rate limit exceeded"},
+		{"error object", `{"error": {"message": "Plan limit reached", "code": 432}}`, "Plan limit reached"},
+		{"message", `{"message": "Internal server error"}`, "Internal server error"},
+		{"plain text", "upstream connect error\n", "upstream connect error"},
+		{"unrecognized json", `{"status": "failed"}`, `{"status": "failed"}`},
+		{"empty body", "", "Bad Gateway"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := parseAPIError(http.StatusBadGateway, []byte(tt.body))
+			if got.Message != tt.want {
+				t.Errorf("parseAPIError(%q).Message = %q, want %q", tt.body, got.Message, tt.want)
+			}
+		})
+	}
+
+	long := strings.Repeat("x", 2*maxErrorBody)
+	if got := parseAPIError(http.StatusBadGateway, []byte(long)).Message; len(got) != maxErrorBody+len("...") {
+		t.Errorf("parseAPIError() message length = %d, want %d", len(got), maxErrorBody+len("..."))
+	}
+}
+
+func TestAPIErrorDisambiguation(t *testing.T) {
+	tests := []struct {
+		statusCode   int
+		message      string
+		forbidden    bool
+		planLimit    bool
+		keyDisabled  bool
+		accessDenied bool
+	}{
+		{403, "Access denied", true, false, false, true},
+		{403, "Your API key is deactivated", true, false, true, false},
+		{403, "Monthly usage limit reached", true, true, false, false},
+		{432, "This request exceeds your plan's set usage limit.", false, true, false, false},
+		{433, "This request exceeds the pay-as-you-go limit.", false, true, false, false},
+		{401, "Invalid API key", false, false, false, false},
+	}
+
+	for _, tt := range tests {
+		err := &APIError{StatusCode: tt.statusCode, Message: tt.message}
+		got := []bool{err.IsForbidden(), err.IsPlanLimitExceeded(), err.IsKeyDisabled(), err.IsAccessDenied()}
+		want := []bool{tt.forbidden, tt.planLimit, tt.keyDisabled, tt.accessDenied}
+		if !slices.Equal(got, want) {
+			t.Errorf("%d %q: forbidden, plan limit, key disabled, access denied = %v, want %v",
+				tt.statusCode, tt.message, got, want)
+		}
+	}
+}
+
 func TestSearchRequest(t *testing.T) {
 	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		if r.Method != http.MethodPost {
@@ -292,6 +384,63 @@ func TestConvenienceMethods(t *testing.T) {
 	})
 }
 
+func TestSearchSince(t *testing.T) {
+	clock := tavilytest.NewFakeClock(time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC))
+
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body = nil
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://example.com/new", "published_date": "2025-06-14T09:00:00Z"},
+			{"url": "https://example.com/same-day", "published_date": "2025-06-12"},
+			{"url": "https://example.com/old", "published_date": "2025-06-10T23:00:00Z"},
+			{"url": "https://example.com/undated"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: clock})
+	since := time.Date(2025, 6, 12, 18, 0, 0, 0, time.UTC)
+
+	result, err := client.SearchSince(context.Background(), "test", since)
+	if err != nil {
+		t.Fatalf("SearchSince() error = %v", err)
+	}
+	if body["time_range"] != "week" {
+		t.Errorf("SearchSince() time_range = %v, want week", body["time_range"])
+	}
+	want := []string{"https://example.com/new", "https://example.com/same-day", "https://example.com/undated"}
+	if got := resultURLList(result.Results); !slices.Equal(got, want) {
+		t.Errorf("SearchSince() results = %v, want %v", got, want)
+	}
+
+	news := WithContextOptions(context.Background(), CallOptions{Search: &SearchOptions{Topic: string(TopicNews)}})
+	if _, err := client.SearchSince(news, "test", since); err != nil {
+		t.Fatalf("SearchSince() error = %v", err)
+	}
+	if body["days"] != float64(3) || body["time_range"] != nil {
+		t.Errorf("SearchSince() for news sent days = %v, time_range = %v, want 3 days", body["days"], body["time_range"])
+	}
+
+	if _, err := client.SearchSince(context.Background(), "test", clock.Now().Add(time.Hour)); err == nil {
+		t.Error("SearchSince() with a future time error = nil, want error")
+	}
+
+	// A year of time_range would drop older results the caller asked for.
+	result, err = client.SearchSince(context.Background(), "test", clock.Now().AddDate(-2, 0, 0))
+	if err != nil {
+		t.Fatalf("SearchSince() error = %v", err)
+	}
+	if body["time_range"] != nil || body["days"] != nil {
+		t.Errorf("SearchSince() two years back sent time_range = %v, days = %v, want neither", body["time_range"], body["days"])
+	}
+	if len(result.Results) != 4 {
+		t.Errorf("SearchSince() two years back results = %v, want all 4", resultURLList(result.Results))
+	}
+}
+
 func TestHelperFunctions(t *testing.T) {
 	t.Run("BoolPtr", func(t *testing.T) {
 		val := BoolPtr(true)
@@ -425,3 +574,819 @@ func BenchmarkSearch(b *testing.B) {
 		}
 	}
 }
+
+func TestCategoryValidation(t *testing.T) {
+	client := New("tvly-test-key", nil)
+	ctx := context.Background()
+
+	_, err := client.Crawl(ctx, "https://example.com", &CrawlOptions{
+		Categories: []CrawlCategory{CategoryBlog, "Recipes"},
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if !apiErr.IsBadRequest() {
+		t.Error("Expected bad request error")
+	}
+	if !strings.Contains(apiErr.Message, `"Recipes"`) {
+		t.Errorf("Expected error message to name the invalid category, got %v", apiErr.Message)
+	}
+}
+
+func TestInstructionsBuilder(t *testing.T) {
+	got := NewInstructions().
+		About("pricing", "billing").
+		UpdatedAfter(time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)).
+		Excluding("job postings").
+		Build()
+
+	want := "Only include pages about pricing or billing. Only include pages updated after 2025-03-01. Skip pages about job postings."
+	if got != want {
+		t.Errorf("Build() = %q, want %q", got, want)
+	}
+
+	if got := NewInstructions().Build(); got != "" {
+		t.Errorf("Build() on empty builder = %q, want empty", got)
+	}
+}
+
+func TestPathSelectors(t *testing.T) {
+	patterns, err := PathsFromGlobs("/docs/**", "/blog/*.html")
+	if err != nil {
+		t.Fatalf("PathsFromGlobs() error = %v", err)
+	}
+
+	if _, err := PathsFromRegex("/api/(v1"); err == nil {
+		t.Error("Expected error for invalid regex, got nil")
+	}
+
+	matcher, err := NewPathMatcher(&CrawlOptions{
+		SelectPaths:  patterns,
+		ExcludePaths: []string{"/docs/internal/.*"},
+	})
+	if err != nil {
+		t.Fatalf("NewPathMatcher() error = %v", err)
+	}
+
+	admitted, rejected := matcher.Filter([]string{
+		"https://example.com/docs/intro",
+		"https://example.com/docs/internal/secrets",
+		"https://example.com/blog/post.html",
+		"https://example.com/blog/2025/post.html",
+	})
+	if len(admitted) != 2 || len(rejected) != 2 {
+		t.Errorf("Filter() admitted = %v, rejected = %v", admitted, rejected)
+	}
+}
+
+func TestGrouping(t *testing.T) {
+	results := []SearchResult{
+		{URL: "https://a.com/1", Score: 0.5, PublishedDate: "2025-03-01"},
+		{URL: "https://b.com/1", Score: 0.9, PublishedDate: "Mon, 03 Mar 2025 10:00:00 GMT"},
+		{URL: "https://a.com/2", Score: 0.7},
+		{URL: "https://a.com/3", Score: 0.6, PublishedDate: "2025-03-01T08:00:00Z"},
+	}
+
+	byDomain := GroupByDomain(results, 2)
+	if len(byDomain) != 2 || byDomain[0].Key != "b.com" {
+		t.Fatalf("GroupByDomain() = %+v", byDomain)
+	}
+	if a := byDomain[1]; a.Total != 3 || len(a.Results) != 2 || a.Results[0].Score != 0.7 {
+		t.Errorf("GroupByDomain() a.com group = %+v", a)
+	}
+
+	byDate := GroupByDate(results, 0)
+	keys := make([]string, len(byDate))
+	for i, g := range byDate {
+		keys[i] = g.Key
+	}
+	if got := strings.Join(keys, ","); got != "2025-03-03,2025-03-01,unknown" {
+		t.Errorf("GroupByDate() keys = %v", got)
+	}
+}
+
+func TestMaxResultsPerDomain(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if len(req.ExcludeDomains) == 0 {
+			w.Write([]byte(`{"query": "test", "results": [
+				{"url": "https://a.com/1", "score": 0.9},
+				{"url": "https://a.com/2", "score": 0.8},
+				{"url": "https://a.com/3", "score": 0.7},
+				{"url": "https://b.com/1", "score": 0.6}
+			]}`))
+			return
+		}
+		if req.ExcludeDomains[0] != "a.com" {
+			t.Errorf("follow-up ExcludeDomains = %v, want a.com first", req.ExcludeDomains)
+		}
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://c.com/1", "score": 0.5},
+			{"url": "https://d.com/1", "score": 0.4}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	result, err := client.Search(context.Background(), "test", &SearchOptions{
+		MaxResults:          4,
+		MaxResultsPerDomain: 1,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if calls != 2 {
+		t.Errorf("Search() made %d requests, want 2", calls)
+	}
+	if len(result.Results) != 4 {
+		t.Errorf("Search() results count = %v, want %v", len(result.Results), 4)
+	}
+}
+
+func TestFillResultsAfterFiltering(t *testing.T) {
+	var followUp SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if len(req.ExcludeDomains) == 0 {
+			w.Write([]byte(`{"query": "test", "results": [
+				{"url": "https://a.com/1", "score": 0.9},
+				{"url": "https://www.a.com/1/", "score": 0.9},
+				{"url": "https://b.com/1", "score": 0.1}
+			]}`))
+			return
+		}
+		followUp = req
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://c.com/1", "score": 0.8}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	result, err := client.Search(context.Background(), "test", &SearchOptions{
+		MaxResults:  3,
+		MinScore:    0.5,
+		Dedup:       true,
+		FillResults: true,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := strings.Join(followUp.ExcludeDomains, ","); got != "a.com,b.com" {
+		t.Errorf("follow-up ExcludeDomains = %v", got)
+	}
+	if len(result.Results) != 2 {
+		t.Errorf("Search() results count = %v, want %v", len(result.Results), 2)
+	}
+}
+
+func TestDomainScorerFilter(t *testing.T) {
+	var followUp SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if len(req.ExcludeDomains) == 0 {
+			w.Write([]byte(`{"query": "test", "results": [
+				{"url": "https://news.tabloid.com/1", "score": 0.9},
+				{"url": "https://reuters.com/1", "score": 0.8}
+			]}`))
+			return
+		}
+		followUp = req
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://apnews.com/1", "score": 0.7}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	result, err := client.Search(context.Background(), "test", &SearchOptions{
+		MaxResults:   2,
+		DomainScorer: &DomainLists{Deny: []string{"tabloid.com"}},
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := strings.Join(followUp.ExcludeDomains, ","); got != "news.tabloid.com" {
+		t.Errorf("follow-up ExcludeDomains = %v, want news.tabloid.com", got)
+	}
+	if got := strings.Join(resultURLList(result.Results), ","); got != "https://reuters.com/1,https://apnews.com/1" {
+		t.Errorf("Search() results = %v", got)
+	}
+}
+
+func TestSearchPage(t *testing.T) {
+	var requests []SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		requests = append(requests, req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if len(req.ExcludeDomains) == 0 {
+			w.Write([]byte(`{"query": "test", "results": [{"url": "https://a.com/1", "score": 0.9}]}`))
+			return
+		}
+		w.Write([]byte(`{"query": "test", "results": [{"url": "https://b.com/1", "score": 0.8}]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	ctx := context.Background()
+	result, err := client.SearchPage(ctx, "test", 2, nil)
+	if err != nil {
+		t.Fatalf("SearchPage() error = %v", err)
+	}
+	if len(requests) != 2 {
+		t.Fatalf("SearchPage() made %d requests, want 2", len(requests))
+	}
+	if got := requests[1].ExcludeDomains; len(got) != 1 || got[0] != "a.com" {
+		t.Errorf("page 2 ExcludeDomains = %v, want [a.com]", got)
+	}
+	if result.Results[0].URL != "https://b.com/1" {
+		t.Errorf("SearchPage() result URL = %v, want %v", result.Results[0].URL, "https://b.com/1")
+	}
+
+	if _, err := client.SearchPage(ctx, "test", 2, nil); err != nil {
+		t.Fatalf("SearchPage() error = %v", err)
+	}
+	if len(requests) != 3 {
+		t.Errorf("SearchPage() refetched stored pages, made %d requests total", len(requests))
+	}
+
+	// Other filters page separately, so page 1 is fetched again.
+	if _, err := client.SearchPage(ctx, "test", 2, &SearchOptions{Topic: "news"}); err != nil {
+		t.Fatalf("SearchPage() error = %v", err)
+	}
+	if len(requests) != 5 {
+		t.Errorf("SearchPage() with another topic made %d requests total, want 5", len(requests))
+	}
+
+	if _, err := client.SearchPage(ctx, "test", 0, nil); err == nil {
+		t.Error("Expected error for page 0, got nil")
+	}
+}
+
+func TestPageKey(t *testing.T) {
+	since := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	base := pageKey("Go  Generics", &SearchOptions{IncludeDomains: []string{"go.dev", "github.com"}, Since: since})
+
+	same := []*SearchOptions{
+		{IncludeDomains: []string{"GitHub.com", "go.dev", "go.dev"}, Since: since.In(time.FixedZone("CEST", 2*3600))},
+		{Topic: "general", IncludeDomains: []string{"github.com", "go.dev"}, Since: since},
+	}
+	for _, opts := range same {
+		if got := pageKey("go generics", opts); got != base {
+			t.Errorf("pageKey(%+v) = %q, want %q", opts, got, base)
+		}
+	}
+
+	different := []*SearchOptions{
+		{IncludeDomains: []string{"go.dev"}, Since: since},
+		{IncludeDomains: []string{"go.dev", "github.com"}, ExcludeDomains: []string{"reddit.com"}, Since: since},
+		{IncludeDomains: []string{"go.dev", "github.com"}, Since: since, Topic: "news"},
+		{IncludeDomains: []string{"go.dev", "github.com"}, Since: since, SearchDepth: "advanced"},
+		{IncludeDomains: []string{"go.dev", "github.com"}, Within: 24 * time.Hour},
+		{IncludeDomains: []string{"go.dev", "github.com"}, TimeRange: "week"},
+	}
+	for _, opts := range different {
+		if got := pageKey("go generics", opts); got == base {
+			t.Errorf("pageKey(%+v) = %q, want it to differ", opts, got)
+		}
+	}
+}
+
+func TestSearchAcrossTopics(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		switch req.Topic {
+		case "news":
+			w.Write([]byte(`{"query": "test", "results": [
+				{"url": "https://news.com/1", "score": 0.9},
+				{"url": "https://shared.com/1", "score": 0.3}
+			]}`))
+		default:
+			w.Write([]byte(`{"query": "test", "results": [{"url": "https://shared.com/1", "score": 0.5}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	result, err := client.SearchAcrossTopics(context.Background(), "test", nil, TopicGeneral, TopicNews)
+	if err != nil {
+		t.Fatalf("SearchAcrossTopics() error = %v", err)
+	}
+	if len(result.Results) != 2 {
+		t.Fatalf("SearchAcrossTopics() results count = %v, want %v", len(result.Results), 2)
+	}
+	if result.Results[0].Topic != TopicNews {
+		t.Errorf("first result topic = %v, want %v", result.Results[0].Topic, TopicNews)
+	}
+	if result.Results[1].Topic != TopicGeneral || result.Results[1].Score != 0.5 {
+		t.Errorf("shared result = %+v, want highest-scoring general copy", result.Results[1])
+	}
+}
+
+func TestResolveTimeWindow(t *testing.T) {
+	now := time.Date(2025, 6, 15, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		opts      SearchOptions
+		wantRange string
+		wantDays  int
+		wantErr   bool
+	}{
+		{name: "within three days", opts: SearchOptions{Within: 72 * time.Hour}, wantRange: "week"},
+		{name: "since for news", opts: SearchOptions{Topic: "news", Since: now.Add(-36 * time.Hour)}, wantDays: 2},
+		{name: "legacy time range", opts: SearchOptions{TimeRange: "month"}, wantRange: "month"},
+		{name: "unknown time range", opts: SearchOptions{TimeRange: "decade"}, wantErr: true},
+		{name: "conflicting settings", opts: SearchOptions{TimeRange: "day", Within: time.Hour}, wantErr: true},
+		{name: "negative within", opts: SearchOptions{Within: -time.Hour}, wantErr: true},
+		{name: "until in the past", opts: SearchOptions{Since: now.Add(-30 * day), Until: now.Add(-10 * day)}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timeRange, days, err := resolveTimeWindow(&tt.opts, now)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("resolveTimeWindow() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if timeRange != tt.wantRange || days != tt.wantDays {
+				t.Errorf("resolveTimeWindow() = %q, %d, want %q, %d", timeRange, days, tt.wantRange, tt.wantDays)
+			}
+		})
+	}
+}
+
+func TestRequestIDPropagation(t *testing.T) {
+	var headers []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = append(headers, r.Header.Get(RequestIDHeader))
+		w.Header().Set("Content-Type", "application/json")
+		if strings.Contains(r.URL.Path, "extract") {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail": {"error": "bad urls"}}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	ctx := WithRequestID(context.Background(), "trace-123")
+	result, err := client.Search(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if headers[0] != "trace-123" || result.Meta.RequestID != "trace-123" {
+		t.Errorf("request ID header = %q, meta = %q, want %q", headers[0], result.Meta.RequestID, "trace-123")
+	}
+
+	_, err = client.Extract(context.Background(), []string{"https://example.com"}, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if apiErr.RequestID == "" || apiErr.RequestID != headers[1] {
+		t.Errorf("APIError.RequestID = %q, want generated header %q", apiErr.RequestID, headers[1])
+	}
+}
+
+func TestWithBaseURL(t *testing.T) {
+	newServer := func(name string, hits *int) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			*hits++
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"query": "` + name + `", "results": []}`))
+		}))
+	}
+	var primaryHits, regionalHits int
+	primary := newServer("primary", &primaryHits)
+	defer primary.Close()
+	regional := newServer("regional", &regionalHits)
+	defer regional.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: primary.URL,
+	})
+
+	result, err := client.Search(WithBaseURL(context.Background(), regional.URL+"/"), "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Query != "regional" {
+		t.Errorf("overridden Search() served by %q, want %q", result.Query, "regional")
+	}
+
+	if _, err := client.Search(context.Background(), "test", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if primaryHits != 1 || regionalHits != 1 {
+		t.Errorf("hits primary = %d, regional = %d, want 1 each", primaryHits, regionalHits)
+	}
+}
+
+func TestClose(t *testing.T) {
+	release := make(chan struct{})
+	started := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Retry:   &RetryPolicy{MaxAttempts: 1},
+	})
+
+	errc := make(chan error, 1)
+	go func() {
+		_, err := client.Search(context.Background(), "test", nil)
+		errc <- err
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	close(release)
+
+	if err := <-errc; err == nil {
+		t.Error("Expected in-flight search to be aborted, got nil")
+	}
+	if _, err := client.Search(context.Background(), "test", nil); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("Search() after Close error = %v, want %v", err, ErrClientClosed)
+	}
+	if err := client.Close(context.Background()); err != nil {
+		t.Errorf("second Close() error = %v", err)
+	}
+}
+
+func TestCloseAbortsDirectFetches(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	var once sync.Once
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		once.Do(func() { close(started) })
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer site.Close()
+	defer close(release)
+
+	client := New("tvly-test-key", nil)
+	results := []SearchResult{{URL: site.URL + "/a"}, {URL: site.URL + "/b"}}
+	enriched := make(chan struct{})
+	go func() {
+		client.EnrichResults(context.Background(), results, &EnrichOptions{Timeout: time.Minute})
+		close(enriched)
+	}()
+	<-started
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := client.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Close() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	select {
+	case <-enriched:
+	default:
+		t.Fatal("EnrichResults() still running after Close returned")
+	}
+	for _, r := range results {
+		if r.Metadata == nil || r.Metadata.Error == "" {
+			t.Errorf("EnrichResults() metadata = %+v, want an aborted check", r.Metadata)
+		}
+	}
+	if _, err := client.ResolveURL(context.Background(), site.URL); !errors.Is(err, ErrClientClosed) {
+		t.Errorf("ResolveURL() after Close error = %v, want %v", err, ErrClientClosed)
+	}
+}
+
+func TestResponseMeta(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response_time": 0.2, "results": [
+			{"url": "https://example.com/1", "raw_content": "a"},
+			{"url": "https://example.com/2", "raw_content": "b"},
+			{"url": "https://example.com/3", "raw_content": "c"},
+			{"url": "https://example.com/4", "raw_content": "d"},
+			{"url": "https://example.com/5", "raw_content": "e"},
+			{"url": "https://example.com/6", "raw_content": "f"}
+		], "failed_results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	result, err := client.Extract(context.Background(), []string{"https://example.com/1"}, &ExtractOptions{
+		ExtractDepth: string(SearchDepthAdvanced),
+	})
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	meta := result.Meta
+	if meta.Attempts != 1 || meta.CacheHit || meta.ElapsedWallClock <= 0 {
+		t.Errorf("Extract() meta = %+v", meta)
+	}
+	if meta.EstimatedCredits != 4 {
+		t.Errorf("Extract() EstimatedCredits = %v, want %v", meta.EstimatedCredits, 4)
+	}
+}
+
+func TestResponseValidation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://example.com", "score": 0.5, "published_date": "2025-01-02"},
+			{"url": "", "score": 12.5, "published_date": "last tuesday"}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:           server.URL,
+		ValidateResponses: true,
+	})
+
+	result, err := client.Search(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	var fields []string
+	for _, d := range result.Meta.SchemaDrift {
+		fields = append(fields, d.Field)
+	}
+	want := "results[1].url,results[1].score,results[1].published_date"
+	if got := strings.Join(fields, ","); got != want {
+		t.Errorf("SchemaDrift fields = %v, want %v", got, want)
+	}
+}
+
+func TestTypedConstants(t *testing.T) {
+	if depth, err := ParseSearchDepth(" Advanced "); err != nil || depth != SearchDepthAdvanced {
+		t.Errorf("ParseSearchDepth() = %v, %v", depth, err)
+	}
+	if _, err := ParseTopic("sports"); err == nil || !strings.Contains(err.Error(), "general, news, finance") {
+		t.Errorf("ParseTopic() error = %v, want list of valid topics", err)
+	}
+	if country, err := ParseCountry("United_States"); err != nil || country != CountryUnitedStates {
+		t.Errorf("ParseCountry() = %v, %v", country, err)
+	}
+	if !TimeRangeW.IsValid() || Format("html").IsValid() {
+		t.Error("IsValid() returned unexpected result")
+	}
+	if got := len(CrawlCategory("").Values()); got != 22 {
+		t.Errorf("CrawlCategory.Values() count = %v, want %v", got, 22)
+	}
+}
+
+func TestExtractDepthValidation(t *testing.T) {
+	if depth, err := ParseExtractDepth("ADVANCED"); err != nil || depth != ExtractDepthAdvanced {
+		t.Errorf("ParseExtractDepth() = %v, %v", depth, err)
+	}
+
+	client := New("tvly-test-key", nil)
+	_, err := client.Extract(context.Background(), []string{"https://example.com"}, &ExtractOptions{ExtractDepth: "fast"})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() || !strings.Contains(apiErr.Message, `unknown ExtractDepth "fast"`) {
+		t.Errorf("Extract() error = %v, want bad request for unknown ExtractDepth", err)
+	}
+
+	_, err = client.Crawl(context.Background(), "https://example.com", &CrawlOptions{ExtractDepth: "deep"})
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("Crawl() error = %v, want bad request for unknown ExtractDepth", err)
+	}
+}
+
+func TestSearchOptionsValidation(t *testing.T) {
+	client := New("tvly-test-key", nil)
+
+	_, err := client.Search(context.Background(), "test", &SearchOptions{
+		ChunksPerSource:          5,
+		IncludeImages:            BoolPtr(false),
+		IncludeImageDescriptions: BoolPtr(true),
+		Topic:                    string(TopicNews),
+		Country:                  "atlantis",
+	})
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Fatalf("Search() error = %v, want bad request *APIError", err)
+	}
+	for _, want := range []string{
+		"ChunksPerSource must be between 1 and 3",
+		"ChunksPerSource requires SearchDepth",
+		"IncludeImageDescriptions requires IncludeImages",
+		`unknown Country "atlantis"`,
+		`Country requires Topic "general"`,
+	} {
+		if !strings.Contains(apiErr.Message, want) {
+			t.Errorf("error message %q does not mention %q", apiErr.Message, want)
+		}
+	}
+}
+
+func TestGetSearchContextReader(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"title": "A", "url": "https://a.com", "content": "alpha", "score": 0.9},
+			{"title": "B", "url": "https://b.com", "content": "beta", "score": 0.8}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+	ctx := context.Background()
+
+	r, err := client.GetSearchContextReader(ctx, "golang", 0)
+	if err != nil {
+		t.Fatalf("GetSearchContextReader() error = %v", err)
+	}
+	streamed, err := io.ReadAll(iotest.OneByteReader(r))
+	if err != nil {
+		t.Fatalf("ReadAll() error = %v", err)
+	}
+
+	want := "Search query: golang\n\n" +
+		"Source 1: A\nURL: https://a.com\nID: " + ResultID(SearchResult{URL: "https://a.com", Content: "alpha"}) + "\nContent: alpha\n\n" +
+		"Source 2: B\nURL: https://b.com\nID: " + ResultID(SearchResult{URL: "https://b.com", Content: "beta"}) + "\nContent: beta\n\n"
+	if string(streamed) != want {
+		t.Errorf("streamed context = %q, want %q", streamed, want)
+	}
+
+	full, err := client.GetSearchContext(ctx, "golang", 0)
+	if err != nil || full != want {
+		t.Errorf("GetSearchContext() = %q, %v, want %q", full, err, want)
+	}
+}
+
+func TestSearchContextTemplate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		if req.MaxResults == nil || *req.MaxResults != 2 {
+			t.Errorf("MaxResults = %v, want 2", req.MaxResults)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"title": "A", "url": "https://a.com", "content": "alpha", "score": 0.9},
+			{"title": "B", "url": "https://b.com", "content": "beta", "score": 0.75}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	formatter, err := NewContextTemplate("Q: {{.}}\n", `[{{.N}}] {{.Title}} ({{printf "%.2f" .Score}}): {{.Content}}`+"\n")
+	if err != nil {
+		t.Fatalf("NewContextTemplate() error = %v", err)
+	}
+	r, err := client.SearchContext(context.Background(), "golang", &ContextOptions{MaxResults: 2, Formatter: formatter})
+	if err != nil {
+		t.Fatalf("SearchContext() error = %v", err)
+	}
+	got, _ := io.ReadAll(r)
+	if want := "Q: golang\n[1] A (0.90): alpha\n[2] B (0.75): beta\n"; string(got) != want {
+		t.Errorf("SearchContext() = %q, want %q", got, want)
+	}
+
+	if _, err := NewContextTemplate("{{.", ""); err == nil {
+		t.Error("NewContextTemplate() with invalid header succeeded")
+	}
+}
+
+func TestServiceGroups(t *testing.T) {
+	var paths []string
+	var bodies []map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]any
+		json.NewDecoder(r.Body).Decode(&body)
+		paths = append(paths, r.URL.Path)
+		bodies = append(bodies, body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	ctx := context.Background()
+
+	if _, err := client.SearchService().News(ctx, "q", 3); err != nil {
+		t.Fatalf("SearchService().News() error = %v", err)
+	}
+	if _, err := client.ExtractService().Simple(ctx, "https://example.com"); err != nil {
+		t.Fatalf("ExtractService().Simple() error = %v", err)
+	}
+	if _, err := client.MapService().Run(ctx, "https://example.com", nil); err != nil {
+		t.Fatalf("MapService().Run() error = %v", err)
+	}
+
+	wantPaths := []string{"/search", "/extract", "/map"}
+	if !slices.Equal(paths, wantPaths) {
+		t.Fatalf("paths = %v, want %v", paths, wantPaths)
+	}
+	if bodies[0]["topic"] != "news" || bodies[0]["days"] != float64(3) {
+		t.Errorf("SearchService().News() body = %v, want news topic over 3 days", bodies[0])
+	}
+}
+
+func TestKeepRawResponses(t *testing.T) {
+	body := `{"query": "q", "results": [], "usage": {"credits": 1}}`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	resp, err := New("tvly-test-key", &Options{BaseURL: server.URL}).Search(context.Background(), "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if resp.Raw() != nil {
+		t.Errorf("Raw() = %s, want nil without KeepRawResponses", resp.Raw())
+	}
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, KeepRawResponses: true})
+	resp, err = client.Search(context.Background(), "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if string(resp.Raw()) != body {
+		t.Errorf("Raw() = %s, want %s", resp.Raw(), body)
+	}
+
+	var extra struct {
+		Usage struct {
+			Credits int `json:"credits"`
+		} `json:"usage"`
+	}
+	if err := json.Unmarshal(resp.Raw(), &extra); err != nil || extra.Usage.Credits != 1 {
+		t.Errorf("unmodeled field usage.credits = %v, %v, want 1", extra.Usage.Credits, err)
+	}
+}
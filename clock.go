@@ -0,0 +1,36 @@
+package tavily
+
+import (
+	"context"
+	"time"
+)
+
+// Clock is the time source behind retry backoff, request pacing (Limiter,
+// AutoPace, Politeness), cache expiry (MemoryCache, AnswerCache, MapMemo),
+// quota windows, failover cooldowns and the timestamps of responses. Replace
+// it, e.g. with tavilytest.FakeClock, to test time-dependent behavior
+// deterministically without real sleeps.
+type Clock interface {
+	Now() time.Time
+	// Sleep blocks for d or until ctx is done, returning ctx.Err() in the latter case.
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// SystemClock is the real wall clock, used wherever no Clock is configured.
+var SystemClock Clock = systemClock{}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time { return time.Now() }
+
+func (systemClock) Sleep(ctx context.Context, d time.Duration) error {
+	return sleepContext(ctx, d)
+}
+
+// clockOr returns c, or SystemClock when c is nil.
+func clockOr(c Clock) Clock {
+	if c == nil {
+		return SystemClock
+	}
+	return c
+}
@@ -0,0 +1,50 @@
+package tavily
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestClockDrivesCacheExpiry(t *testing.T) {
+	clock := tavilytest.NewFakeClock(time.Now())
+	cache := &MemoryCache{Clock: clock}
+	answers := &AnswerCache{TTL: time.Minute, Clock: clock}
+
+	cache.Set("k", []byte("v"), time.Minute)
+	answers.set("q", "a")
+
+	clock.Advance(59 * time.Second)
+	if _, ok := cache.Get("k"); !ok {
+		t.Error("MemoryCache entry expired before its TTL")
+	}
+	if _, ok := answers.get("q"); !ok {
+		t.Error("AnswerCache entry expired before its TTL")
+	}
+
+	clock.Advance(2 * time.Second)
+	if _, ok := cache.Get("k"); ok {
+		t.Error("MemoryCache entry outlived its TTL")
+	}
+	if _, ok := answers.get("q"); ok {
+		t.Error("AnswerCache entry outlived its TTL")
+	}
+}
+
+func TestClockDrivesLimiter(t *testing.T) {
+	clock := tavilytest.NewFakeClock(time.Now())
+	clock.AutoAdvance = true
+	limiter := &Limiter{RequestsPerMinute: 60, Clock: clock}
+
+	for range 3 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	want := []time.Duration{time.Second, time.Second}
+	if got := clock.Sleeps(); len(got) != 2 || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Sleeps() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,85 @@
+package tavily
+
+import (
+	"strings"
+	"unicode"
+)
+
+// confidenceSources is the number of top results an answer is checked against.
+const confidenceSources = 5
+
+// AnswerConfidence is a heuristic signal of how well the sources back an answer.
+// Every field is in [0, 1].
+type AnswerConfidence struct {
+	// Score blends the signals below: half Coverage, a quarter each
+	// SourceScore and Agreement.
+	Score float64
+	// Coverage is the share of the answer's key terms found in any top source.
+	Coverage float64
+	// SourceScore is the average relevance score of the top sources.
+	SourceScore float64
+	// Agreement is the average share of answer terms each top source contains,
+	// high when the sources independently say the same thing.
+	Agreement float64
+}
+
+// AnswerConfidence estimates how well the answer is supported by the top
+// results, so applications can decide between showing the answer and showing
+// raw results. It returns the zero value when there is no answer or no results.
+func (r *SearchResponse) AnswerConfidence() AnswerConfidence {
+	terms := keyTerms(r.Answer)
+	sources := r.Results[:min(len(r.Results), confidenceSources)]
+	if len(terms) == 0 || len(sources) == 0 {
+		return AnswerConfidence{}
+	}
+
+	var c AnswerConfidence
+	covered := make(map[string]bool)
+	for _, res := range sources {
+		c.SourceScore += min(max(res.Score, 0), 1)
+
+		text := make(map[string]bool)
+		for _, term := range keyTerms(res.Title + " " + res.Content) {
+			text[term] = true
+		}
+		found := 0
+		for _, term := range terms {
+			if text[term] {
+				found++
+				covered[term] = true
+			}
+		}
+		c.Agreement += float64(found) / float64(len(terms))
+	}
+
+	c.Coverage = float64(len(covered)) / float64(len(terms))
+	c.SourceScore /= float64(len(sources))
+	c.Agreement /= float64(len(sources))
+	c.Score = 0.5*c.Coverage + 0.25*c.SourceScore + 0.25*c.Agreement
+	return c
+}
+
+var stopwords = map[string]bool{
+	"about": true, "also": true, "and": true, "are": true, "but": true, "can": true,
+	"for": true, "from": true, "has": true, "have": true, "its": true, "into": true,
+	"not": true, "that": true, "the": true, "their": true, "there": true, "these": true,
+	"this": true, "was": true, "were": true, "which": true, "while": true, "with": true,
+	"will": true, "would": true,
+}
+
+// keyTerms returns the distinct lowercase words of text that carry meaning:
+// at least three characters and not a stopword.
+func keyTerms(text string) []string {
+	seen := make(map[string]bool)
+	var terms []string
+	for _, w := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	}) {
+		if len(w) < 3 || stopwords[w] || seen[w] {
+			continue
+		}
+		seen[w] = true
+		terms = append(terms, w)
+	}
+	return terms
+}
@@ -0,0 +1,36 @@
+package tavily
+
+import (
+	"testing"
+)
+
+func TestAnswerConfidence(t *testing.T) {
+	answer := "Go was designed at Google by Griesemer, Pike and Thompson."
+
+	supported := &SearchResponse{
+		Answer: answer,
+		Results: []SearchResult{
+			{Content: "Go was designed at Google in 2007 by Robert Griesemer, Rob Pike, and Ken Thompson.", Score: 0.9},
+			{Content: "Griesemer, Pike and Thompson designed Go at Google.", Score: 0.8},
+		},
+	}
+	unsupported := &SearchResponse{
+		Answer: answer,
+		Results: []SearchResult{
+			{Content: "Rust is a systems programming language.", Score: 0.3},
+			{Content: "Python emphasizes readability.", Score: 0.2},
+		},
+	}
+
+	high, low := supported.AnswerConfidence(), unsupported.AnswerConfidence()
+	if high.Coverage != 1 || high.Agreement != 1 {
+		t.Errorf("supported coverage = %v, agreement = %v, want 1, 1", high.Coverage, high.Agreement)
+	}
+	if low.Coverage != 0 || low.Score >= high.Score {
+		t.Errorf("unsupported coverage = %v, score = %v, want 0 and below %v", low.Coverage, low.Score, high.Score)
+	}
+
+	if got := (&SearchResponse{Results: supported.Results}).AnswerConfidence(); got != (AnswerConfidence{}) {
+		t.Errorf("AnswerConfidence() without answer = %+v, want zero", got)
+	}
+}
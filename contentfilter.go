@@ -0,0 +1,76 @@
+package tavily
+
+import (
+	"regexp"
+	"strings"
+)
+
+// ContentFilter screens text before it is returned to callers, for products that
+// must not surface NSFW or otherwise restricted content from web search.
+// Implementations must be safe for concurrent use.
+type ContentFilter interface {
+	// Check reports whether text must be withheld, and why.
+	Check(text string) (reason string, blocked bool)
+}
+
+// FilteredContent records an item withheld by the client's ContentFilter.
+type FilteredContent struct {
+	// URL of the dropped result, or empty for the answer.
+	URL    string
+	Reason string
+}
+
+// KeywordFilter is a basic ContentFilter blocking text that contains any of a
+// list of words or phrases, matched case-insensitively on word boundaries.
+type KeywordFilter struct {
+	pattern *regexp.Regexp
+}
+
+// NewKeywordFilter creates a KeywordFilter for keywords.
+func NewKeywordFilter(keywords ...string) *KeywordFilter {
+	quoted := make([]string, 0, len(keywords))
+	for _, k := range keywords {
+		if k = strings.TrimSpace(k); k != "" {
+			quoted = append(quoted, regexp.QuoteMeta(k))
+		}
+	}
+	if len(quoted) == 0 {
+		return &KeywordFilter{}
+	}
+	// \b only knows ASCII word characters, so boundaries are spelled out to
+	// cover non-Latin keywords and keywords ending in punctuation like "c++".
+	return &KeywordFilter{pattern: regexp.MustCompile(
+		`(?i)(?:^|[^\p{L}\p{N}_])(` + strings.Join(quoted, "|") + `)(?:$|[^\p{L}\p{N}_])`)}
+}
+
+// Check implements ContentFilter.
+func (f *KeywordFilter) Check(text string) (string, bool) {
+	if f.pattern == nil {
+		return "", false
+	}
+	if m := f.pattern.FindStringSubmatch(text); m != nil {
+		return "matched keyword " + strings.ToLower(m[1]), true
+	}
+	return "", false
+}
+
+// applyContentFilter drops blocked results and clears a blocked answer,
+// recording what was withheld in resp.FilteredContent.
+func applyContentFilter(resp *SearchResponse, filter ContentFilter) {
+	if resp.Answer != "" {
+		if reason, blocked := filter.Check(resp.Answer); blocked {
+			resp.Answer = ""
+			resp.FilteredContent = append(resp.FilteredContent, FilteredContent{Reason: reason})
+		}
+	}
+
+	kept := resp.Results[:0]
+	for _, r := range resp.Results {
+		if reason, blocked := filter.Check(r.Title + "\n" + r.Content + "\n" + r.RawContent); blocked {
+			resp.FilteredContent = append(resp.FilteredContent, FilteredContent{URL: r.URL, Reason: reason})
+			continue
+		}
+		kept = append(kept, r)
+	}
+	resp.Results = kept
+}
@@ -0,0 +1,73 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestContentFilter(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "answer": "Casino bonuses explained.", "results": [
+			{"url": "https://safe.com", "title": "Safe", "content": "Classic board games.", "score": 0.9},
+			{"url": "https://bad.com", "title": "Online CASINO deals", "content": "Spin now.", "score": 0.8},
+			{"url": "https://edge.com", "title": "Casinos", "content": "Plural is not a keyword match.", "score": 0.7}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:       server.URL,
+		ContentFilter: NewKeywordFilter("casino", "slot machine"),
+	})
+
+	result, err := client.Search(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Answer != "" {
+		t.Errorf("Answer = %q, want it withheld", result.Answer)
+	}
+	if len(result.Results) != 2 || result.Results[1].URL != "https://edge.com" {
+		t.Errorf("Results = %v, want safe.com and edge.com", resultURLList(result.Results))
+	}
+
+	want := []FilteredContent{
+		{Reason: "matched keyword casino"},
+		{URL: "https://bad.com", Reason: "matched keyword casino"},
+	}
+	if len(result.FilteredContent) != len(want) {
+		t.Fatalf("FilteredContent = %+v, want %+v", result.FilteredContent, want)
+	}
+	for i := range want {
+		if result.FilteredContent[i] != want[i] {
+			t.Errorf("FilteredContent[%d] = %+v, want %+v", i, result.FilteredContent[i], want[i])
+		}
+	}
+}
+
+func TestKeywordFilter(t *testing.T) {
+	filter := NewKeywordFilter("casino", "казино", "c++", "slot machine")
+	tests := []struct {
+		text       string
+		wantReason string
+	}{
+		{text: "Online Casino deals", wantReason: "matched keyword casino"},
+		{text: "Casinos are plural", wantReason: ""},
+		{text: "Лучшее КАЗИНО онлайн", wantReason: "matched keyword казино"},
+		{text: "казинолюбитель", wantReason: ""},
+		{text: "Learn C++ today", wantReason: "matched keyword c++"},
+		{text: "Written in c++.", wantReason: "matched keyword c++"},
+		{text: "Try the slot machine", wantReason: "matched keyword slot machine"},
+		{text: "Board games", wantReason: ""},
+	}
+	for _, tt := range tests {
+		reason, blocked := filter.Check(tt.text)
+		if reason != tt.wantReason || blocked != (tt.wantReason != "") {
+			t.Errorf("Check(%q) = %q, %v, want %q", tt.text, reason, blocked, tt.wantReason)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package tavily
+
+import (
+	"net/url"
+	"path"
+	"strings"
+)
+
+// DocumentKind classifies a URL by the kind of document it points to.
+type DocumentKind string
+
+const (
+	DocumentPage   DocumentKind = "page"
+	DocumentPDF    DocumentKind = "pdf"
+	DocumentBinary DocumentKind = "binary"
+)
+
+// BinaryPolicy decides how Extract handles URLs detected as DocumentBinary.
+type BinaryPolicy string
+
+const (
+	// BinarySend passes binary URLs to the API unchanged.
+	BinarySend BinaryPolicy = ""
+	// BinarySkip leaves binary URLs out of the request and reports each as a
+	// failed result with SkipReason set, without spending credits.
+	BinarySkip BinaryPolicy = "skip"
+	// BinaryFail rejects the whole call with a 400 APIError.
+	BinaryFail BinaryPolicy = "fail"
+)
+
+// binaryExtensions are file types the extract endpoint cannot turn into text.
+var binaryExtensions = map[string]bool{
+	".7z": true, ".apk": true, ".avi": true, ".bin": true, ".bmp": true, ".dmg": true,
+	".exe": true, ".gif": true, ".gz": true, ".ico": true, ".iso": true, ".jar": true,
+	".jpeg": true, ".jpg": true, ".m4a": true, ".mkv": true, ".mov": true, ".mp3": true,
+	".mp4": true, ".msi": true, ".png": true, ".rar": true, ".tar": true, ".tgz": true,
+	".wasm": true, ".wav": true, ".webm": true, ".webp": true, ".woff": true, ".woff2": true,
+	".xz": true, ".zip": true,
+}
+
+// DetectDocumentKind classifies rawURL by its path extension. URLs without a
+// recognized extension are assumed to be pages.
+func DetectDocumentKind(rawURL string) DocumentKind {
+	switch ext := urlExtension(rawURL); {
+	case ext == ".pdf":
+		return DocumentPDF
+	case binaryExtensions[ext]:
+		return DocumentBinary
+	default:
+		return DocumentPage
+	}
+}
+
+func urlExtension(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.ToLower(path.Ext(u.Path))
+}
+
+// partitionBinary splits urls into those to send and skipped failed results
+// for binary ones.
+func partitionBinary(urls []string) (send []string, skipped []ExtractFailedResult) {
+	for _, u := range urls {
+		if DetectDocumentKind(u) != DocumentBinary {
+			send = append(send, u)
+			continue
+		}
+		reason := "binary content (" + urlExtension(u) + ") cannot be extracted"
+		skipped = append(skipped, ExtractFailedResult{
+			URL:        u,
+			Error:      "skipped: " + reason,
+			Reason:     ReasonUnsupported,
+			SkipReason: reason,
+		})
+	}
+	return send, skipped
+}
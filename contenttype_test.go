@@ -0,0 +1,64 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDetectDocumentKind(t *testing.T) {
+	tests := []struct {
+		url  string
+		want DocumentKind
+	}{
+		{url: "https://example.com/docs/", want: DocumentPage},
+		{url: "https://example.com/report.PDF?download=1", want: DocumentPDF},
+		{url: "https://example.com/release.tar.gz", want: DocumentBinary},
+		{url: "https://example.com/logo.png", want: DocumentBinary},
+		{url: "https://example.com/page.html", want: DocumentPage},
+	}
+	for _, tt := range tests {
+		if got := DetectDocumentKind(tt.url); got != tt.want {
+			t.Errorf("DetectDocumentKind(%q) = %v, want %v", tt.url, got, tt.want)
+		}
+	}
+}
+
+func TestExtractBinaryPolicy(t *testing.T) {
+	var sent []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ExtractRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.URLs
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"results": [{"url": "https://example.com/paper.pdf", "raw_content": "abstract"}]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+	ctx := context.Background()
+	urls := []string{"https://example.com/paper.pdf", "https://example.com/archive.zip"}
+
+	result, err := client.Extract(ctx, urls, &ExtractOptions{Binary: BinarySkip})
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if len(sent) != 1 || sent[0] != urls[0] {
+		t.Errorf("sent URLs = %v, want only the PDF", sent)
+	}
+	if len(result.FailedResults) != 1 || result.FailedResults[0].SkipReason == "" {
+		t.Errorf("FailedResults = %+v, want the archive skipped with a reason", result.FailedResults)
+	}
+
+	_, err = client.Extract(ctx, urls, &ExtractOptions{Binary: BinaryFail})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("Extract() error = %v, want bad request", err)
+	}
+}
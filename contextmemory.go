@@ -0,0 +1,96 @@
+package tavily
+
+import "sync"
+
+// ContextStore records which sources a conversation has already been given.
+// Implementations must be safe for concurrent use; back it with a shared store
+// to track conversations across processes.
+type ContextStore interface {
+	Seen(conversation, key string) bool
+	Mark(conversation string, keys ...string)
+}
+
+// MemoryContextStore is an in-process ContextStore. The zero value is ready to use.
+type MemoryContextStore struct {
+	mu   sync.Mutex
+	seen map[string]map[string]bool
+}
+
+// Seen implements ContextStore.
+func (s *MemoryContextStore) Seen(conversation, key string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seen[conversation][key]
+}
+
+// Mark implements ContextStore.
+func (s *MemoryContextStore) Mark(conversation string, keys ...string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.seen == nil {
+		s.seen = make(map[string]map[string]bool)
+	}
+	if s.seen[conversation] == nil {
+		s.seen[conversation] = make(map[string]bool)
+	}
+	for _, key := range keys {
+		s.seen[conversation][key] = true
+	}
+}
+
+// ContextMemory tracks the sources provided in earlier turns of a conversation
+// so later SearchContext calls can leave them out, saving tokens in multi-turn
+// RAG chats.
+type ContextMemory struct {
+	// Conversation identifies the chat in Store.
+	Conversation string
+	// Store defaults to a MemoryContextStore private to this ContextMemory.
+	Store ContextStore
+	// ByURL treats any chunk of an already provided URL as repeated. By default
+	// only identical chunks (same ResultID) are.
+	ByURL bool
+	// Compress keeps repeated sources as a one-line reference instead of
+	// dropping them, so the model can still cite them.
+	Compress bool
+
+	once sync.Once
+}
+
+func (m *ContextMemory) store() ContextStore {
+	m.once.Do(func() {
+		if m.Store == nil {
+			m.Store = &MemoryContextStore{}
+		}
+	})
+	return m.Store
+}
+
+func (m *ContextMemory) key(r SearchResult) string {
+	if m.ByURL {
+		return "url:" + normalizeResultURL(r.URL)
+	}
+	return "id:" + resultIDOf(r)
+}
+
+// apply drops or compresses results provided in earlier turns and records
+// the rest as provided.
+func (m *ContextMemory) apply(results []SearchResult) []SearchResult {
+	store := m.store()
+	var kept []SearchResult
+	var fresh []string
+	for _, r := range results {
+		key := m.key(r)
+		if !store.Seen(m.Conversation, key) {
+			fresh = append(fresh, key)
+			kept = append(kept, r)
+			continue
+		}
+		if m.Compress {
+			r.Content = "(provided in an earlier turn)"
+			r.RawContent = ""
+			kept = append(kept, r)
+		}
+	}
+	store.Mark(m.Conversation, fresh...)
+	return kept
+}
@@ -0,0 +1,67 @@
+package tavily
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestContextMemory(t *testing.T) {
+	turn := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		turn++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if turn == 1 {
+			w.Write([]byte(`{"query": "test", "results": [
+				{"title": "A", "url": "https://a.com", "content": "alpha", "score": 0.9}
+			]}`))
+			return
+		}
+		w.Write([]byte(`{"query": "test", "results": [
+			{"title": "A", "url": "https://a.com", "content": "alpha", "score": 0.9},
+			{"title": "B", "url": "https://b.com", "content": "beta", "score": 0.8}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+	formatter, _ := NewContextTemplate("", "{{.Title}}: {{.Content}} [{{.ID}}]\n")
+
+	render := func(memory *ContextMemory) string {
+		t.Helper()
+		r, err := client.SearchContext(context.Background(), "test", &ContextOptions{Formatter: formatter, Memory: memory})
+		if err != nil {
+			t.Fatalf("SearchContext() error = %v", err)
+		}
+		out, _ := io.ReadAll(r)
+		return string(out)
+	}
+
+	store := &MemoryContextStore{}
+	render(&ContextMemory{Conversation: "chat-1", Store: store})
+
+	idA := ResultID(SearchResult{URL: "https://a.com", Content: "alpha"})
+	idB := ResultID(SearchResult{URL: "https://b.com", Content: "beta"})
+
+	got := render(&ContextMemory{Conversation: "chat-1", Store: store, Compress: true})
+	want := "A: (provided in an earlier turn) [" + idA + "]\nB: beta [" + idB + "]\n"
+	if got != want {
+		t.Errorf("compressed context = %q, want %q", got, want)
+	}
+
+	got = render(&ContextMemory{Conversation: "chat-1", Store: store})
+	if got != "" {
+		t.Errorf("context after both sources were provided = %q, want empty", got)
+	}
+
+	got = render(&ContextMemory{Conversation: "chat-2", Store: store})
+	if !strings.Contains(got, "A: alpha") || !strings.Contains(got, "B: beta") {
+		t.Errorf("context for a new conversation = %q, want both sources", got)
+	}
+}
@@ -0,0 +1,342 @@
+package tavily
+
+// Country boosts search results from a specific country. Only applies to the general topic.
+type Country string
+
+const (
+	CountryAfghanistan            Country = "afghanistan"
+	CountryAlbania                Country = "albania"
+	CountryAlgeria                Country = "algeria"
+	CountryAndorra                Country = "andorra"
+	CountryAngola                 Country = "angola"
+	CountryArgentina              Country = "argentina"
+	CountryArmenia                Country = "armenia"
+	CountryAustralia              Country = "australia"
+	CountryAustria                Country = "austria"
+	CountryAzerbaijan             Country = "azerbaijan"
+	CountryBahamas                Country = "bahamas"
+	CountryBahrain                Country = "bahrain"
+	CountryBangladesh             Country = "bangladesh"
+	CountryBarbados               Country = "barbados"
+	CountryBelarus                Country = "belarus"
+	CountryBelgium                Country = "belgium"
+	CountryBelize                 Country = "belize"
+	CountryBenin                  Country = "benin"
+	CountryBhutan                 Country = "bhutan"
+	CountryBolivia                Country = "bolivia"
+	CountryBosniaAndHerzegovina   Country = "bosnia and herzegovina"
+	CountryBotswana               Country = "botswana"
+	CountryBrazil                 Country = "brazil"
+	CountryBrunei                 Country = "brunei"
+	CountryBulgaria               Country = "bulgaria"
+	CountryBurkinaFaso            Country = "burkina faso"
+	CountryBurundi                Country = "burundi"
+	CountryCambodia               Country = "cambodia"
+	CountryCameroon               Country = "cameroon"
+	CountryCanada                 Country = "canada"
+	CountryCapeVerde              Country = "cape verde"
+	CountryCentralAfricanRepublic Country = "central african republic"
+	CountryChad                   Country = "chad"
+	CountryChile                  Country = "chile"
+	CountryChina                  Country = "china"
+	CountryColombia               Country = "colombia"
+	CountryComoros                Country = "comoros"
+	CountryCongo                  Country = "congo"
+	CountryCostaRica              Country = "costa rica"
+	CountryCroatia                Country = "croatia"
+	CountryCuba                   Country = "cuba"
+	CountryCyprus                 Country = "cyprus"
+	CountryCzechRepublic          Country = "czech republic"
+	CountryDenmark                Country = "denmark"
+	CountryDjibouti               Country = "djibouti"
+	CountryDominicanRepublic      Country = "dominican republic"
+	CountryEcuador                Country = "ecuador"
+	CountryEgypt                  Country = "egypt"
+	CountryElSalvador             Country = "el salvador"
+	CountryEquatorialGuinea       Country = "equatorial guinea"
+	CountryEritrea                Country = "eritrea"
+	CountryEstonia                Country = "estonia"
+	CountryEthiopia               Country = "ethiopia"
+	CountryFiji                   Country = "fiji"
+	CountryFinland                Country = "finland"
+	CountryFrance                 Country = "france"
+	CountryGabon                  Country = "gabon"
+	CountryGambia                 Country = "gambia"
+	CountryGeorgia                Country = "georgia"
+	CountryGermany                Country = "germany"
+	CountryGhana                  Country = "ghana"
+	CountryGreece                 Country = "greece"
+	CountryGuatemala              Country = "guatemala"
+	CountryGuinea                 Country = "guinea"
+	CountryHaiti                  Country = "haiti"
+	CountryHonduras               Country = "honduras"
+	CountryHungary                Country = "hungary"
+	CountryIceland                Country = "iceland"
+	CountryIndia                  Country = "india"
+	CountryIndonesia              Country = "indonesia"
+	CountryIran                   Country = "iran"
+	CountryIraq                   Country = "iraq"
+	CountryIreland                Country = "ireland"
+	CountryIsrael                 Country = "israel"
+	CountryItaly                  Country = "italy"
+	CountryJamaica                Country = "jamaica"
+	CountryJapan                  Country = "japan"
+	CountryJordan                 Country = "jordan"
+	CountryKazakhstan             Country = "kazakhstan"
+	CountryKenya                  Country = "kenya"
+	CountryKuwait                 Country = "kuwait"
+	CountryKyrgyzstan             Country = "kyrgyzstan"
+	CountryLatvia                 Country = "latvia"
+	CountryLebanon                Country = "lebanon"
+	CountryLesotho                Country = "lesotho"
+	CountryLiberia                Country = "liberia"
+	CountryLibya                  Country = "libya"
+	CountryLiechtenstein          Country = "liechtenstein"
+	CountryLithuania              Country = "lithuania"
+	CountryLuxembourg             Country = "luxembourg"
+	CountryMadagascar             Country = "madagascar"
+	CountryMalawi                 Country = "malawi"
+	CountryMalaysia               Country = "malaysia"
+	CountryMaldives               Country = "maldives"
+	CountryMali                   Country = "mali"
+	CountryMalta                  Country = "malta"
+	CountryMauritania             Country = "mauritania"
+	CountryMauritius              Country = "mauritius"
+	CountryMexico                 Country = "mexico"
+	CountryMoldova                Country = "moldova"
+	CountryMonaco                 Country = "monaco"
+	CountryMongolia               Country = "mongolia"
+	CountryMontenegro             Country = "montenegro"
+	CountryMorocco                Country = "morocco"
+	CountryMozambique             Country = "mozambique"
+	CountryMyanmar                Country = "myanmar"
+	CountryNamibia                Country = "namibia"
+	CountryNepal                  Country = "nepal"
+	CountryNetherlands            Country = "netherlands"
+	CountryNewZealand             Country = "new zealand"
+	CountryNicaragua              Country = "nicaragua"
+	CountryNiger                  Country = "niger"
+	CountryNigeria                Country = "nigeria"
+	CountryNorthKorea             Country = "north korea"
+	CountryNorthMacedonia         Country = "north macedonia"
+	CountryNorway                 Country = "norway"
+	CountryOman                   Country = "oman"
+	CountryPakistan               Country = "pakistan"
+	CountryPanama                 Country = "panama"
+	CountryPapuaNewGuinea         Country = "papua new guinea"
+	CountryParaguay               Country = "paraguay"
+	CountryPeru                   Country = "peru"
+	CountryPhilippines            Country = "philippines"
+	CountryPoland                 Country = "poland"
+	CountryPortugal               Country = "portugal"
+	CountryQatar                  Country = "qatar"
+	CountryRomania                Country = "romania"
+	CountryRussia                 Country = "russia"
+	CountryRwanda                 Country = "rwanda"
+	CountrySaudiArabia            Country = "saudi arabia"
+	CountrySenegal                Country = "senegal"
+	CountrySerbia                 Country = "serbia"
+	CountrySingapore              Country = "singapore"
+	CountrySlovakia               Country = "slovakia"
+	CountrySlovenia               Country = "slovenia"
+	CountrySomalia                Country = "somalia"
+	CountrySouthAfrica            Country = "south africa"
+	CountrySouthKorea             Country = "south korea"
+	CountrySouthSudan             Country = "south sudan"
+	CountrySpain                  Country = "spain"
+	CountrySriLanka               Country = "sri lanka"
+	CountrySudan                  Country = "sudan"
+	CountrySweden                 Country = "sweden"
+	CountrySwitzerland            Country = "switzerland"
+	CountrySyria                  Country = "syria"
+	CountryTaiwan                 Country = "taiwan"
+	CountryTajikistan             Country = "tajikistan"
+	CountryTanzania               Country = "tanzania"
+	CountryThailand               Country = "thailand"
+	CountryTogo                   Country = "togo"
+	CountryTrinidadAndTobago      Country = "trinidad and tobago"
+	CountryTunisia                Country = "tunisia"
+	CountryTurkey                 Country = "turkey"
+	CountryTurkmenistan           Country = "turkmenistan"
+	CountryUganda                 Country = "uganda"
+	CountryUkraine                Country = "ukraine"
+	CountryUnitedArabEmirates     Country = "united arab emirates"
+	CountryUnitedKingdom          Country = "united kingdom"
+	CountryUnitedStates           Country = "united states"
+	CountryUruguay                Country = "uruguay"
+	CountryUzbekistan             Country = "uzbekistan"
+	CountryVenezuela              Country = "venezuela"
+	CountryVietnam                Country = "vietnam"
+	CountryYemen                  Country = "yemen"
+	CountryZambia                 Country = "zambia"
+	CountryZimbabwe               Country = "zimbabwe"
+)
+
+var countries = []Country{
+	CountryAfghanistan,
+	CountryAlbania,
+	CountryAlgeria,
+	CountryAndorra,
+	CountryAngola,
+	CountryArgentina,
+	CountryArmenia,
+	CountryAustralia,
+	CountryAustria,
+	CountryAzerbaijan,
+	CountryBahamas,
+	CountryBahrain,
+	CountryBangladesh,
+	CountryBarbados,
+	CountryBelarus,
+	CountryBelgium,
+	CountryBelize,
+	CountryBenin,
+	CountryBhutan,
+	CountryBolivia,
+	CountryBosniaAndHerzegovina,
+	CountryBotswana,
+	CountryBrazil,
+	CountryBrunei,
+	CountryBulgaria,
+	CountryBurkinaFaso,
+	CountryBurundi,
+	CountryCambodia,
+	CountryCameroon,
+	CountryCanada,
+	CountryCapeVerde,
+	CountryCentralAfricanRepublic,
+	CountryChad,
+	CountryChile,
+	CountryChina,
+	CountryColombia,
+	CountryComoros,
+	CountryCongo,
+	CountryCostaRica,
+	CountryCroatia,
+	CountryCuba,
+	CountryCyprus,
+	CountryCzechRepublic,
+	CountryDenmark,
+	CountryDjibouti,
+	CountryDominicanRepublic,
+	CountryEcuador,
+	CountryEgypt,
+	CountryElSalvador,
+	CountryEquatorialGuinea,
+	CountryEritrea,
+	CountryEstonia,
+	CountryEthiopia,
+	CountryFiji,
+	CountryFinland,
+	CountryFrance,
+	CountryGabon,
+	CountryGambia,
+	CountryGeorgia,
+	CountryGermany,
+	CountryGhana,
+	CountryGreece,
+	CountryGuatemala,
+	CountryGuinea,
+	CountryHaiti,
+	CountryHonduras,
+	CountryHungary,
+	CountryIceland,
+	CountryIndia,
+	CountryIndonesia,
+	CountryIran,
+	CountryIraq,
+	CountryIreland,
+	CountryIsrael,
+	CountryItaly,
+	CountryJamaica,
+	CountryJapan,
+	CountryJordan,
+	CountryKazakhstan,
+	CountryKenya,
+	CountryKuwait,
+	CountryKyrgyzstan,
+	CountryLatvia,
+	CountryLebanon,
+	CountryLesotho,
+	CountryLiberia,
+	CountryLibya,
+	CountryLiechtenstein,
+	CountryLithuania,
+	CountryLuxembourg,
+	CountryMadagascar,
+	CountryMalawi,
+	CountryMalaysia,
+	CountryMaldives,
+	CountryMali,
+	CountryMalta,
+	CountryMauritania,
+	CountryMauritius,
+	CountryMexico,
+	CountryMoldova,
+	CountryMonaco,
+	CountryMongolia,
+	CountryMontenegro,
+	CountryMorocco,
+	CountryMozambique,
+	CountryMyanmar,
+	CountryNamibia,
+	CountryNepal,
+	CountryNetherlands,
+	CountryNewZealand,
+	CountryNicaragua,
+	CountryNiger,
+	CountryNigeria,
+	CountryNorthKorea,
+	CountryNorthMacedonia,
+	CountryNorway,
+	CountryOman,
+	CountryPakistan,
+	CountryPanama,
+	CountryPapuaNewGuinea,
+	CountryParaguay,
+	CountryPeru,
+	CountryPhilippines,
+	CountryPoland,
+	CountryPortugal,
+	CountryQatar,
+	CountryRomania,
+	CountryRussia,
+	CountryRwanda,
+	CountrySaudiArabia,
+	CountrySenegal,
+	CountrySerbia,
+	CountrySingapore,
+	CountrySlovakia,
+	CountrySlovenia,
+	CountrySomalia,
+	CountrySouthAfrica,
+	CountrySouthKorea,
+	CountrySouthSudan,
+	CountrySpain,
+	CountrySriLanka,
+	CountrySudan,
+	CountrySweden,
+	CountrySwitzerland,
+	CountrySyria,
+	CountryTaiwan,
+	CountryTajikistan,
+	CountryTanzania,
+	CountryThailand,
+	CountryTogo,
+	CountryTrinidadAndTobago,
+	CountryTunisia,
+	CountryTurkey,
+	CountryTurkmenistan,
+	CountryUganda,
+	CountryUkraine,
+	CountryUnitedArabEmirates,
+	CountryUnitedKingdom,
+	CountryUnitedStates,
+	CountryUruguay,
+	CountryUzbekistan,
+	CountryVenezuela,
+	CountryVietnam,
+	CountryYemen,
+	CountryZambia,
+	CountryZimbabwe,
+}
@@ -0,0 +1,91 @@
+package tavily
+
+import (
+	"net/url"
+	"strings"
+)
+
+// CrawlStats summarizes a crawl so its options can be sanity-checked.
+type CrawlStats struct {
+	Pages int
+	// TotalContentBytes and AverageContentBytes measure RawContent length.
+	TotalContentBytes   int
+	AverageContentBytes int
+	// EmptyPages counts pages without raw content.
+	EmptyPages int
+	Images     int
+	// Depths counts pages by path depth relative to the crawl's base URL; pages
+	// on other hosts or outside the base path are counted under -1.
+	Depths map[int]int
+	// PathGroups counts pages by their first path segment, e.g. "/docs".
+	// Pages on other hosts are grouped under their host.
+	PathGroups map[string]int
+}
+
+// Stats summarizes the crawled pages: sizes, images, depth distribution and
+// counts per path group.
+func (r *CrawlResponse) Stats() CrawlStats {
+	stats := CrawlStats{
+		Pages:      len(r.Results),
+		Depths:     make(map[int]int),
+		PathGroups: make(map[string]int),
+	}
+
+	base, _ := url.Parse(r.BaseURL)
+	for _, res := range r.Results {
+		stats.TotalContentBytes += len(res.RawContent)
+		if strings.TrimSpace(res.RawContent) == "" {
+			stats.EmptyPages++
+		}
+		stats.Images += len(res.Images)
+
+		u, err := url.Parse(res.URL)
+		if err != nil {
+			stats.Depths[-1]++
+			stats.PathGroups[res.URL]++
+			continue
+		}
+		stats.Depths[crawlDepth(base, u)]++
+		stats.PathGroups[pathGroup(base, u)]++
+	}
+	if stats.Pages > 0 {
+		stats.AverageContentBytes = stats.TotalContentBytes / stats.Pages
+	}
+	return stats
+}
+
+func pathSegments(p string) []string {
+	return strings.FieldsFunc(p, func(r rune) bool { return r == '/' })
+}
+
+func sameHost(base, u *url.URL) bool {
+	return base != nil && strings.EqualFold(
+		strings.TrimPrefix(base.Hostname(), "www."),
+		strings.TrimPrefix(u.Hostname(), "www."))
+}
+
+func crawlDepth(base, u *url.URL) int {
+	if !sameHost(base, u) {
+		return -1
+	}
+	baseSegs, segs := pathSegments(base.Path), pathSegments(u.Path)
+	if len(segs) < len(baseSegs) {
+		return -1
+	}
+	for i, seg := range baseSegs {
+		if segs[i] != seg {
+			return -1
+		}
+	}
+	return len(segs) - len(baseSegs)
+}
+
+func pathGroup(base, u *url.URL) string {
+	if base != nil && base.Host != "" && !sameHost(base, u) {
+		return u.Host
+	}
+	if segs := pathSegments(u.Path); len(segs) > 0 {
+		return "/" + segs[0]
+	}
+	return "/"
+}
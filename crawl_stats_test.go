@@ -0,0 +1,41 @@
+package tavily
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCrawlStats(t *testing.T) {
+	resp := &CrawlResponse{
+		BaseURL: "https://docs.example.com/guide",
+		Results: []CrawlResult{
+			{URL: "https://docs.example.com/guide", RawContent: strings.Repeat("a", 100), Images: []string{"x.png"}},
+			{URL: "https://docs.example.com/guide/intro", RawContent: strings.Repeat("b", 50)},
+			{URL: "https://docs.example.com/guide/api/search", RawContent: strings.Repeat("c", 30), Images: []string{"y.png", "z.png"}},
+			{URL: "https://docs.example.com/blog/post", RawContent: ""},
+			{URL: "https://github.com/example/repo", RawContent: strings.Repeat("d", 20)},
+		},
+	}
+
+	stats := resp.Stats()
+	if stats.Pages != 5 || stats.TotalContentBytes != 200 || stats.AverageContentBytes != 40 {
+		t.Errorf("Stats() pages = %d, total = %d, average = %d, want 5, 200, 40",
+			stats.Pages, stats.TotalContentBytes, stats.AverageContentBytes)
+	}
+	if stats.EmptyPages != 1 || stats.Images != 3 {
+		t.Errorf("Stats() empty = %d, images = %d, want 1, 3", stats.EmptyPages, stats.Images)
+	}
+
+	wantDepths := map[int]int{0: 1, 1: 1, 2: 1, -1: 2}
+	for depth, want := range wantDepths {
+		if got := stats.Depths[depth]; got != want {
+			t.Errorf("Stats().Depths[%d] = %d, want %d", depth, got, want)
+		}
+	}
+	wantGroups := map[string]int{"/guide": 3, "/blog": 1, "github.com": 1}
+	for group, want := range wantGroups {
+		if got := stats.PathGroups[group]; got != want {
+			t.Errorf("Stats().PathGroups[%q] = %d, want %d", group, got, want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package tavily
+
+import (
+	"context"
+	"slices"
+	"strings"
+)
+
+// DocumentationCrawl returns the options CrawlDocumentation starts from. Pass
+// it to Crawl with Merge to adapt it to a site's layout:
+//
+//	opts := tavily.DocumentationCrawl().Merge(&tavily.CrawlOptions{SelectPaths: []string{"^/handbook/.*"}})
+func DocumentationCrawl() *CrawlOptions {
+	return &CrawlOptions{
+		MaxDepth:   3,
+		Categories: []CrawlCategory{CategoryDocumentation, CategoryDeveloper},
+		SelectPaths: presetPaths(
+			"/docs/**", "/doc/**", "/documentation/**", "/api/**", "/reference/**", "/guide/**",
+			"/guides/**", "/tutorial/**", "/tutorials/**", "/manual/**", "/learn/**", "/developers/**",
+		),
+		Format:        string(FormatMarkdown),
+		AllowExternal: BoolPtr(false),
+	}
+}
+
+// BlogCrawl returns the options CrawlBlog starts from. Tag, category, author
+// and pagination listings are left out in favour of the posts themselves.
+func BlogCrawl() *CrawlOptions {
+	return &CrawlOptions{
+		MaxDepth:      2,
+		Categories:    []CrawlCategory{CategoryBlog, CategoryBlogs},
+		SelectPaths:   presetPaths("/blog/**", "/blogs/**", "/news/**", "/posts/**", "/articles/**", "/stories/**"),
+		ExcludePaths:  presetPaths("**/tag/**", "**/tags/**", "**/category/**", "**/author/**", "**/page/**"),
+		Format:        string(FormatMarkdown),
+		AllowExternal: BoolPtr(false),
+	}
+}
+
+// ChangelogCrawl returns the options CrawlChangelog starts from.
+func ChangelogCrawl() *CrawlOptions {
+	return &CrawlOptions{
+		MaxDepth: 2,
+		SelectPaths: presetPaths(
+			"/change-log**", "/releases**", "/whats-new**", "/updates**",
+			"**/changelog**", "**/release-notes**",
+		),
+		Format:        string(FormatMarkdown),
+		AllowExternal: BoolPtr(false),
+	}
+}
+
+// PricingCrawl returns the options CrawlPricing starts from.
+func PricingCrawl() *CrawlOptions {
+	return &CrawlOptions{
+		MaxDepth:      2,
+		Categories:    []CrawlCategory{CategoryPricing, CategoryEnterprise},
+		SelectPaths:   presetPaths("/pricing**", "/plans**", "/price**", "/buy**", "/enterprise**"),
+		Format:        string(FormatMarkdown),
+		AllowExternal: BoolPtr(false),
+	}
+}
+
+// presetPaths converts the presets' globs into the regexes the API expects.
+func presetPaths(globs ...string) []string {
+	paths, err := PathsFromGlobs(globs...)
+	if err != nil {
+		panic(err)
+	}
+	return paths
+}
+
+// Subdomains that hold a whole vertical, where path selectors would only get
+// in the way: docs.example.com keeps its guides at the root.
+var (
+	documentationSubdomains = []string{"docs", "doc", "developer", "developers", "api", "learn", "help"}
+	blogSubdomains          = []string{"blog", "news"}
+	changelogSubdomains     = []string{"changelog", "releases"}
+)
+
+// crawlPreset crawls url with preset, dropping its path selectors when url is
+// on one of subdomains. Crawl defaults from WithContextOptions override the
+// preset, and maxPages, when set, overrides both.
+func (c *Client) crawlPreset(ctx context.Context, url string, preset *CrawlOptions, subdomains []string, maxPages int) (*CrawlResponse, error) {
+	host := domainOf(url)
+	if label, _, ok := strings.Cut(host, "."); ok && slices.Contains(subdomains, label) {
+		preset.SelectPaths = nil
+	}
+	opts := preset.Merge(callOptionsFrom(ctx).Crawl).Merge(&CrawlOptions{Limit: maxPages})
+	return c.Crawl(ctx, url, opts)
+}
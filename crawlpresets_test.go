@@ -0,0 +1,119 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestCrawlPresets(t *testing.T) {
+	var req CrawlRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = CrawlRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"base_url": "https://example.com", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	custom := WithContextOptions(context.Background(), CallOptions{Crawl: &CrawlOptions{SelectPaths: []string{"^/handbook/.*"}, Limit: 5}})
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		crawl     func(ctx context.Context, url string, maxPages int) (*CrawlResponse, error)
+		url       string
+		maxPages  int
+		wantPaths []string
+		wantCats  []CrawlCategory
+		wantLimit int
+	}{
+		{"documentation", context.Background(), client.CrawlDocumentation, "https://example.com", 20,
+			DocumentationCrawl().SelectPaths, []CrawlCategory{CategoryDocumentation, CategoryDeveloper}, 20},
+		{"docs subdomain", context.Background(), client.CrawlDocumentation, "https://docs.example.com", 20,
+			nil, []CrawlCategory{CategoryDocumentation, CategoryDeveloper}, 20},
+		{"context overrides", custom, client.CrawlDocumentation, "https://example.com", 0,
+			[]string{"^/handbook/.*"}, []CrawlCategory{CategoryDocumentation, CategoryDeveloper}, 5},
+		{"max pages beats context", custom, client.CrawlDocumentation, "https://example.com", 30,
+			[]string{"^/handbook/.*"}, []CrawlCategory{CategoryDocumentation, CategoryDeveloper}, 30},
+		{"blog", context.Background(), client.CrawlBlog, "https://example.com", 10,
+			BlogCrawl().SelectPaths, []CrawlCategory{CategoryBlog, CategoryBlogs}, 10},
+		{"blog subdomain", context.Background(), client.CrawlBlog, "https://blog.example.com", 10,
+			nil, []CrawlCategory{CategoryBlog, CategoryBlogs}, 10},
+		{"changelog", context.Background(), client.CrawlChangelog, "https://example.com", 10,
+			ChangelogCrawl().SelectPaths, nil, 10},
+		{"pricing", context.Background(), client.CrawlPricing, "https://example.com", 0,
+			PricingCrawl().SelectPaths, []CrawlCategory{CategoryPricing, CategoryEnterprise}, 50},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.crawl(tt.ctx, tt.url, tt.maxPages); err != nil {
+				t.Fatalf("crawl error = %v", err)
+			}
+			if !slices.Equal(req.SelectPaths, tt.wantPaths) {
+				t.Errorf("select_paths = %v, want %v", req.SelectPaths, tt.wantPaths)
+			}
+			if !slices.Equal(req.Categories, tt.wantCats) {
+				t.Errorf("categories = %v, want %v", req.Categories, tt.wantCats)
+			}
+			if req.Limit == nil || *req.Limit != tt.wantLimit {
+				t.Errorf("limit = %v, want %d", req.Limit, tt.wantLimit)
+			}
+			if req.Format != string(FormatMarkdown) || req.AllowExternal == nil || *req.AllowExternal {
+				t.Errorf("format = %q, allow_external = %v, want markdown and false", req.Format, req.AllowExternal)
+			}
+		})
+	}
+
+	// Presets are fresh values, so changing one does not leak into the helpers.
+	DocumentationCrawl().SelectPaths[0] = "^/changed/.*"
+	if DocumentationCrawl().SelectPaths[0] != "^/docs/.*$" {
+		t.Error("DocumentationCrawl() returned shared state")
+	}
+}
+
+func TestCrawlPresetPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		preset  *CrawlOptions
+		allowed []string
+		denied  []string
+	}{
+		{"documentation", DocumentationCrawl(),
+			[]string{"https://example.com/docs/intro", "https://example.com/api/v1/users"},
+			[]string{"https://example.com/docs", "https://example.com/blog/post"}},
+		{"blog", BlogCrawl(),
+			[]string{"https://example.com/blog/hello-world", "https://example.com/news/2024/launch"},
+			[]string{"https://example.com/blog/tag/go", "https://example.com/blog/page/2", "https://example.com/pricing"}},
+		{"changelog", ChangelogCrawl(),
+			[]string{"https://example.com/changelog", "https://example.com/releases/v2", "https://example.com/docs/release-notes/2024"},
+			[]string{"https://example.com/docs/intro"}},
+		{"pricing", PricingCrawl(),
+			[]string{"https://example.com/pricing", "https://example.com/plans/team"},
+			[]string{"https://example.com/about"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m, err := NewPathMatcher(tt.preset)
+			if err != nil {
+				t.Fatalf("NewPathMatcher() error = %v", err)
+			}
+			for _, u := range tt.allowed {
+				if !m.Allows(u) {
+					t.Errorf("Allows(%q) = false, want true", u)
+				}
+			}
+			for _, u := range tt.denied {
+				if m.Allows(u) {
+					t.Errorf("Allows(%q) = true, want false", u)
+				}
+			}
+		})
+	}
+}
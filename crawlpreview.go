@@ -0,0 +1,66 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+)
+
+// CrawlPreview lists the pages a Crawl with the same options would visit,
+// as discovered by a Map request.
+type CrawlPreview struct {
+	BaseURL string
+	URLs    []string
+	// Limit is the page limit the crawl would run with.
+	Limit int
+	// AtLimit reports whether discovery stopped at Limit, meaning a wider
+	// limit could reach more pages than listed.
+	AtLimit bool
+
+	Meta ResponseMeta
+}
+
+// mapOptions returns the MapOptions that discover the same frontier as o.
+// Extraction-only settings (ExtractDepth, Format, IncludeImages) have no Map counterpart.
+func (o *CrawlOptions) mapOptions() *MapOptions {
+	return &MapOptions{
+		MaxDepth:       o.MaxDepth,
+		MaxBreadth:     o.MaxBreadth,
+		Limit:          o.Limit,
+		Instructions:   o.Instructions,
+		SelectPaths:    o.SelectPaths,
+		SelectDomains:  o.SelectDomains,
+		ExcludePaths:   o.ExcludePaths,
+		ExcludeDomains: o.ExcludeDomains,
+		AllowExternal:  o.AllowExternal,
+		Categories:     o.Categories,
+		Timeout:        o.Timeout,
+	}
+}
+
+// PreviewCrawl runs only a Map request with the selectors, categories and
+// limits of opts, showing which URLs Crawl would visit without paying for
+// content extraction. The Preflight policy of opts is ignored.
+func (c *Client) PreviewCrawl(ctx context.Context, url string, opts *CrawlOptions) (*CrawlPreview, error) {
+	opts = withDefaults(opts, callOptionsFrom(ctx).Crawl)
+	if opts == nil {
+		opts = &CrawlOptions{}
+	}
+
+	resp, err := c.Map(ctx, url, opts.mapOptions())
+	if err != nil {
+		return nil, fmt.Errorf("crawl preview failed: %w", err)
+	}
+
+	limit := 50
+	if l := resolveInt(opts.Limit, limit); l != nil {
+		limit = *l
+	}
+
+	return &CrawlPreview{
+		BaseURL: resp.BaseURL,
+		URLs:    resp.Results,
+		Limit:   limit,
+		AtLimit: limit > 0 && len(resp.Results) >= limit,
+		Meta:    resp.Meta,
+	}, nil
+}
@@ -0,0 +1,59 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestPreviewCrawl(t *testing.T) {
+	var got map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/map" {
+			t.Errorf("path = %q, want /map", r.URL.Path)
+		}
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"base_url": "docs.example.com", "results": ["https://docs.example.com/a", "https://docs.example.com/b"]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	preview, err := client.PreviewCrawl(context.Background(), "https://docs.example.com", &CrawlOptions{
+		MaxDepth:     2,
+		Limit:        2,
+		SelectPaths:  []string{"/docs/.*"},
+		Categories:   []CrawlCategory{CategoryDocumentation},
+		ExtractDepth: "advanced",
+		Format:       "text",
+		Preflight:    PreflightFail,
+	})
+	if err != nil {
+		t.Fatalf("PreviewCrawl() error = %v", err)
+	}
+
+	for key, want := range map[string]any{"max_depth": 2.0, "limit": 2.0, "max_breadth": 20.0} {
+		if got[key] != want {
+			t.Errorf("request %s = %v, want %v", key, got[key], want)
+		}
+	}
+	for _, key := range []string{"extract_depth", "format", "include_images"} {
+		if _, ok := got[key]; ok {
+			t.Errorf("request has crawl-only field %s", key)
+		}
+	}
+	if paths, _ := got["select_paths"].([]any); len(paths) != 1 {
+		t.Errorf("request select_paths = %v, want [/docs/.*]", got["select_paths"])
+	}
+
+	want := []string{"https://docs.example.com/a", "https://docs.example.com/b"}
+	if !slices.Equal(preview.URLs, want) {
+		t.Errorf("URLs = %v, want %v", preview.URLs, want)
+	}
+	if preview.Limit != 2 || !preview.AtLimit {
+		t.Errorf("Limit, AtLimit = %d, %v, want 2, true", preview.Limit, preview.AtLimit)
+	}
+}
@@ -0,0 +1,60 @@
+package tavily
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+)
+
+// CallOptions are call settings carried by a context, for frameworks that
+// cannot thread option structs through every layer down to the Tavily call.
+type CallOptions struct {
+	// RequestID and Tenant behave like WithRequestID and WithTenant.
+	RequestID string
+	Tenant    string
+	// LogAttrs are added to every log record the client emits for the call.
+	LogAttrs []slog.Attr
+
+	// Search, Extract, Crawl and Map supply defaults that the call's own
+	// options are merged onto, with the precedence of SearchOptions.Merge.
+	Search  *SearchOptions
+	Extract *ExtractOptions
+	Crawl   *CrawlOptions
+	Map     *MapOptions
+}
+
+type callOptionsKey struct{}
+
+// WithContextOptions returns a context whose Tavily calls use opts. Options
+// attached by an outer WithContextOptions stay in effect unless opts sets them,
+// with default option structs layered by Merge; LogAttrs accumulate.
+func WithContextOptions(ctx context.Context, opts CallOptions) context.Context {
+	if opts.RequestID != "" {
+		ctx = WithRequestID(ctx, opts.RequestID)
+	}
+	if opts.Tenant != "" {
+		ctx = WithTenant(ctx, opts.Tenant)
+	}
+
+	merged := callOptionsFrom(ctx)
+	merged.LogAttrs = append(slices.Clip(merged.LogAttrs), opts.LogAttrs...)
+	merged.Search = merged.Search.Merge(opts.Search)
+	merged.Extract = merged.Extract.Merge(opts.Extract)
+	merged.Crawl = merged.Crawl.Merge(opts.Crawl)
+	merged.Map = merged.Map.Merge(opts.Map)
+	return context.WithValue(ctx, callOptionsKey{}, merged)
+}
+
+func callOptionsFrom(ctx context.Context) CallOptions {
+	opts, _ := ctx.Value(callOptionsKey{}).(CallOptions)
+	return opts
+}
+
+// withDefaults returns defaults overlaid with opts, or opts unchanged when
+// there are no defaults.
+func withDefaults[T any](opts, defaults *T) *T {
+	if defaults == nil {
+		return opts
+	}
+	return mergeOptions(defaults, opts)
+}
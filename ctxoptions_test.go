@@ -0,0 +1,85 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWithContextOptions(t *testing.T) {
+	var gotID string
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotID = r.Header.Get(RequestIDHeader)
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	quota := &QuotaManager{}
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Logger:  slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Quota:   quota,
+	})
+
+	ctx := WithContextOptions(context.Background(), CallOptions{
+		Tenant:   "acme",
+		LogAttrs: []slog.Attr{slog.String("user", "u1")},
+		Search:   &SearchOptions{Topic: "news", MaxResults: 3},
+	})
+	ctx = WithContextOptions(ctx, CallOptions{
+		RequestID: "req-123",
+		LogAttrs:  []slog.Attr{slog.String("route", "/ask")},
+	})
+
+	if _, err := client.Search(ctx, "q", &SearchOptions{MaxResults: 7}); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if gotID != "req-123" {
+		t.Errorf("request ID = %v, want %v", gotID, "req-123")
+	}
+	if body["topic"] != "news" {
+		t.Errorf("topic = %v, want default %v", body["topic"], "news")
+	}
+	if body["max_results"] != float64(7) {
+		t.Errorf("max_results = %v, want explicit %v", body["max_results"], 7)
+	}
+	if got := quota.Usage("acme").Requests; got != 1 {
+		t.Errorf("Usage(acme).Requests = %v, want %v", got, 1)
+	}
+	for _, attr := range []string{"user=u1", "route=/ask"} {
+		if !strings.Contains(logs.String(), attr) {
+			t.Errorf("logs missing %q:\n%s", attr, logs.String())
+		}
+	}
+}
+
+func TestWithDefaults(t *testing.T) {
+	defaults := &ExtractOptions{ExtractDepth: "advanced", Format: "text"}
+
+	if got := withDefaults(nil, defaults); got.ExtractDepth != "advanced" || got == defaults {
+		t.Errorf("withDefaults(nil) = %+v, want a copy of the defaults", got)
+	}
+
+	opts := &ExtractOptions{Format: "markdown"}
+	got := withDefaults(opts, defaults)
+	if got.ExtractDepth != "advanced" || got.Format != "markdown" {
+		t.Errorf("withDefaults() = %+v, want advanced depth and markdown format", got)
+	}
+	if opts.ExtractDepth != "" {
+		t.Errorf("withDefaults() modified the caller's options: %+v", opts)
+	}
+
+	if got := withDefaults(opts, nil); got != opts {
+		t.Errorf("withDefaults(opts, nil) = %p, want %p", got, opts)
+	}
+}
@@ -0,0 +1,96 @@
+package tavily
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultPartBytes is the part size SplitDocument uses when none is given.
+const DefaultPartBytes = 64 * 1024
+
+// DocumentPart is one piece of a long extracted document.
+type DocumentPart struct {
+	URL string `json:"url"`
+	// Index is the part's position, from 0 to Total-1.
+	Index int `json:"index"`
+	Total int `json:"total"`
+	// Offset is the byte offset of Content in the whole document.
+	Offset  int    `json:"offset"`
+	Content string `json:"content"`
+}
+
+// SplitDocument splits text into ordered parts of at most maxBytes bytes
+// (DefaultPartBytes when maxBytes is 0), for consumers with message-size
+// limits such as queues or LLM calls. Parts end at a paragraph break, line
+// break or space in their second half where there is one, and never inside a
+// UTF-8 sequence, so their contents joined in order give back text exactly.
+// Empty text yields no parts.
+func SplitDocument(url, text string, maxBytes int) []DocumentPart {
+	if maxBytes <= 0 {
+		maxBytes = DefaultPartBytes
+	}
+
+	var parts []DocumentPart
+	for offset := 0; offset < len(text); {
+		end := offset + partEnd(text[offset:], maxBytes)
+		parts = append(parts, DocumentPart{URL: url, Index: len(parts), Offset: offset, Content: text[offset:end]})
+		offset = end
+	}
+	for i := range parts {
+		parts[i].Total = len(parts)
+	}
+	return parts
+}
+
+// partEnd returns the length of the next part of text.
+func partEnd(text string, maxBytes int) int {
+	if len(text) <= maxBytes {
+		return len(text)
+	}
+	window := text[:maxBytes]
+	for _, sep := range []string{"\n\n", "\n", " "} {
+		if i := strings.LastIndex(window, sep); i >= maxBytes/2 {
+			return i + len(sep)
+		}
+	}
+	if n := headEnd(text, maxBytes); n > 0 {
+		return n
+	}
+	// maxBytes is smaller than the first character; keep it whole.
+	_, size := utf8.DecodeRuneInString(text)
+	return size
+}
+
+// Parts splits the result's RawContent; see SplitDocument.
+func (r *ExtractResult) Parts(maxBytes int) []DocumentPart {
+	return SplitDocument(r.URL, r.RawContent, maxBytes)
+}
+
+// ReassembleDocument joins parts produced by SplitDocument back into the
+// document, in any order. It fails if parts belong to different URLs, are
+// missing or repeated, or do not line up at their offsets.
+func ReassembleDocument(parts []DocumentPart) (string, error) {
+	if len(parts) == 0 {
+		return "", nil
+	}
+	sorted := slices.Clone(parts)
+	slices.SortFunc(sorted, func(a, b DocumentPart) int { return a.Index - b.Index })
+
+	var b strings.Builder
+	for i, p := range sorted {
+		switch {
+		case p.URL != sorted[0].URL:
+			return "", fmt.Errorf("part %d is from %s, not %s", p.Index, p.URL, sorted[0].URL)
+		case p.Total != len(sorted):
+			return "", fmt.Errorf("have %d parts of %s, want %d", len(sorted), p.URL, p.Total)
+		case p.Index != i:
+			return "", fmt.Errorf("part %d of %s is missing", i, p.URL)
+		case p.Offset != b.Len():
+			return "", fmt.Errorf("part %d of %s starts at offset %d, want %d", p.Index, p.URL, p.Offset, b.Len())
+		}
+		b.WriteString(p.Content)
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,83 @@
+package tavily
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestSplitDocument(t *testing.T) {
+	tests := []struct {
+		name     string
+		text     string
+		maxBytes int
+		want     []string
+	}{
+		{"short", "Hello.", 10, []string{"Hello."}},
+		{"empty", "", 10, nil},
+		{"paragraphs", "First para.\n\nSecond para.\n\nThird.", 16, []string{"First para.\n\n", "Second para.\n\n", "Third."}},
+		{"words", "alpha beta gamma delta", 12, []string{"alpha beta ", "gamma delta"}},
+		{"no break", "abcdefghij", 4, []string{"abcd", "efgh", "ij"}},
+		{"multibyte", "ééééé", 3, []string{"é", "é", "é", "é", "é"}},
+		{"rune larger than limit", "日本", 2, []string{"日", "本"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parts := SplitDocument("https://example.com/a", tt.text, tt.maxBytes)
+			if len(parts) != len(tt.want) {
+				t.Fatalf("SplitDocument() = %+v, want %q", parts, tt.want)
+			}
+			offset := 0
+			for i, p := range parts {
+				if p.Content != tt.want[i] || p.Index != i || p.Total != len(tt.want) || p.Offset != offset || p.URL != "https://example.com/a" {
+					t.Errorf("SplitDocument()[%d] = %+v, want content %q at offset %d", i, p, tt.want[i], offset)
+				}
+				if !utf8.ValidString(p.Content) {
+					t.Errorf("SplitDocument()[%d] content %q is not valid UTF-8", i, p.Content)
+				}
+				offset += len(p.Content)
+			}
+		})
+	}
+}
+
+func TestReassembleDocument(t *testing.T) {
+	doc := strings.Repeat("Lorem ipsum dolor sit amet, consectetur adipiscing elit.\n\n", 50)
+	result := &ExtractResult{URL: "https://example.com/long", RawContent: doc}
+	parts := result.Parts(500)
+	if len(parts) < 2 {
+		t.Fatalf("Parts() = %d parts, want several", len(parts))
+	}
+	for _, p := range parts {
+		if len(p.Content) > 500 {
+			t.Errorf("Parts() part %d is %d bytes, want at most 500", p.Index, len(p.Content))
+		}
+	}
+
+	reversed := make([]DocumentPart, len(parts))
+	for i, p := range parts {
+		reversed[len(parts)-1-i] = p
+	}
+	got, err := ReassembleDocument(reversed)
+	if err != nil || got != doc {
+		t.Errorf("ReassembleDocument() = %d bytes, %v, want the original %d bytes", len(got), err, len(doc))
+	}
+
+	missing := append(parts[:1:1], parts[2:]...)
+	if _, err := ReassembleDocument(missing); err == nil {
+		t.Error("ReassembleDocument() with a missing part error = nil, want error")
+	}
+
+	other := SplitDocument("https://example.com/other", doc, 500)
+	mixed := append([]DocumentPart{other[0]}, parts[1:]...)
+	if _, err := ReassembleDocument(mixed); err == nil {
+		t.Error("ReassembleDocument() with parts of another URL error = nil, want error")
+	}
+
+	shifted := append([]DocumentPart(nil), parts...)
+	shifted[1].Offset++
+	if _, err := ReassembleDocument(shifted); err == nil {
+		t.Error("ReassembleDocument() with a misaligned part error = nil, want error")
+	}
+}
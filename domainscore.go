@@ -0,0 +1,49 @@
+package tavily
+
+import "strings"
+
+// DomainScorer rates the reputation of result domains, e.g. from allow/deny
+// lists or third-party source ratings. Results from domains scoring below
+// SearchOptions.MinDomainScore are dropped during result filtering.
+// Implementations must be safe for concurrent use.
+type DomainScorer interface {
+	ScoreDomain(domain string) float64
+}
+
+// DomainScorerFunc adapts a function to DomainScorer.
+type DomainScorerFunc func(domain string) float64
+
+// ScoreDomain implements DomainScorer.
+func (f DomainScorerFunc) ScoreDomain(domain string) float64 { return f(domain) }
+
+// DomainLists is a DomainScorer scoring denied domains -1, allowed domains 1
+// and every other domain Default. Entries match the domain and its subdomains,
+// so with the zero MinDomainScore only denied domains are dropped.
+type DomainLists struct {
+	Allow   []string
+	Deny    []string
+	Default float64
+}
+
+// ScoreDomain implements DomainScorer. Deny wins over Allow.
+func (l *DomainLists) ScoreDomain(domain string) float64 {
+	switch {
+	case matchesDomainList(domain, l.Deny):
+		return -1
+	case matchesDomainList(domain, l.Allow):
+		return 1
+	default:
+		return l.Default
+	}
+}
+
+func matchesDomainList(domain string, list []string) bool {
+	domain = strings.TrimPrefix(strings.ToLower(domain), "www.")
+	for _, entry := range list {
+		entry = strings.TrimPrefix(strings.ToLower(entry), "www.")
+		if domain == entry || strings.HasSuffix(domain, "."+entry) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,127 @@
+package tavily
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// encryptedFormat versions the layout of EncryptedCache entries.
+const encryptedFormat = 1
+
+// EncryptionKeys supplies the AES keys of an EncryptedCache, e.g. from a KMS
+// or secret store. Keys are 16, 24 or 32 bytes long, selecting AES-128, -192
+// or -256. Implementations must be safe for concurrent use.
+type EncryptionKeys interface {
+	// CurrentKey returns the key new entries are sealed with and its ID.
+	CurrentKey() (id string, key []byte, err error)
+	// Key returns the key with id, so entries sealed before a key rotation
+	// can still be opened.
+	Key(id string) ([]byte, error)
+}
+
+// StaticKey is EncryptionKeys holding a single key, with an empty ID.
+type StaticKey []byte
+
+// CurrentKey implements EncryptionKeys.
+func (k StaticKey) CurrentKey() (string, []byte, error) { return "", k, nil }
+
+// Key implements EncryptionKeys.
+func (k StaticKey) Key(id string) ([]byte, error) {
+	if id != "" {
+		return nil, fmt.Errorf("unknown key %q", id)
+	}
+	return k, nil
+}
+
+// EncryptedCache wraps a Cache, typically one persisting to disk or a shared
+// store, sealing every response with AES-GCM so cached web content and
+// queries are not stored in plaintext. Cache keys are already hashes of the
+// request. Each entry records the ID of its key and is bound to its cache key,
+// so entries moved between keys fail to open.
+//
+// Cache methods cannot report errors: an entry that cannot be opened is a
+// miss, and a response that cannot be sealed is not stored.
+type EncryptedCache struct {
+	Cache Cache
+	Keys  EncryptionKeys
+}
+
+// Get implements Cache.
+func (c *EncryptedCache) Get(key string) ([]byte, bool) {
+	sealed, ok := c.Cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	value, err := c.open(key, sealed)
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set implements Cache.
+func (c *EncryptedCache) Set(key string, value []byte, ttl time.Duration) {
+	sealed, err := c.seal(key, value)
+	if err != nil {
+		return
+	}
+	c.Cache.Set(key, sealed, ttl)
+}
+
+// seal encrypts value as: format, key ID length, key ID, nonce, ciphertext.
+func (c *EncryptedCache) seal(key string, value []byte) ([]byte, error) {
+	id, secret, err := c.Keys.CurrentKey()
+	if err != nil {
+		return nil, err
+	}
+	if len(id) > 255 {
+		return nil, fmt.Errorf("key ID %q is longer than 255 bytes", id)
+	}
+	aead, err := newGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	header := append([]byte{encryptedFormat, byte(len(id))}, id...)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	sealed := append(header, nonce...)
+	return aead.Seal(sealed, nonce, value, []byte(key)), nil
+}
+
+func (c *EncryptedCache) open(key string, sealed []byte) ([]byte, error) {
+	if len(sealed) < 2 || sealed[0] != encryptedFormat {
+		return nil, errors.New("not an encrypted cache entry")
+	}
+	idEnd := 2 + int(sealed[1])
+	if len(sealed) < idEnd {
+		return nil, errors.New("truncated cache entry")
+	}
+	secret, err := c.Keys.Key(string(sealed[2:idEnd]))
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newGCM(secret)
+	if err != nil {
+		return nil, err
+	}
+	rest := sealed[idEnd:]
+	if len(rest) < aead.NonceSize() {
+		return nil, errors.New("truncated cache entry")
+	}
+	return aead.Open(nil, rest[:aead.NonceSize()], rest[aead.NonceSize():], []byte(key))
+}
+
+func newGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,84 @@
+package tavily
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+)
+
+// rotatingKeys is EncryptionKeys with a current key and older ones.
+type rotatingKeys struct {
+	current string
+	keys    map[string][]byte
+}
+
+func (k *rotatingKeys) CurrentKey() (string, []byte, error) { return k.current, k.keys[k.current], nil }
+
+func (k *rotatingKeys) Key(id string) ([]byte, error) {
+	key, ok := k.keys[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown key %q", id)
+	}
+	return key, nil
+}
+
+func TestEncryptedCache(t *testing.T) {
+	store := &MemoryCache{}
+	cache := &EncryptedCache{Cache: store, Keys: StaticKey(bytes.Repeat([]byte{7}, 32))}
+	value := []byte(`{"query": "secret research", "results": []}`)
+
+	cache.Set("k1", value, 0)
+	stored, _ := store.Get("k1")
+	if bytes.Contains(stored, []byte("secret research")) {
+		t.Errorf("EncryptedCache stored plaintext: %q", stored)
+	}
+	if got, ok := cache.Get("k1"); !ok || !bytes.Equal(got, value) {
+		t.Errorf("EncryptedCache.Get() = %q, %v, want %q, true", got, ok, value)
+	}
+
+	// An entry copied under another cache key fails authentication.
+	store.Set("k2", stored, 0)
+	if _, ok := cache.Get("k2"); ok {
+		t.Errorf("EncryptedCache.Get() of a moved entry ok = true, want false")
+	}
+
+	store.Set("plain", value, 0)
+	if _, ok := cache.Get("plain"); ok {
+		t.Errorf("EncryptedCache.Get() of a plaintext entry ok = true, want false")
+	}
+
+	wrongKey := &EncryptedCache{Cache: store, Keys: StaticKey(bytes.Repeat([]byte{8}, 32))}
+	if _, ok := wrongKey.Get("k1"); ok {
+		t.Errorf("EncryptedCache.Get() with the wrong key ok = true, want false")
+	}
+
+	invalid := &EncryptedCache{Cache: store, Keys: StaticKey([]byte("short"))}
+	invalid.Set("k3", value, 0)
+	if _, ok := store.Get("k3"); ok {
+		t.Errorf("EncryptedCache.Set() with an invalid key stored the value")
+	}
+}
+
+func TestEncryptedCacheKeyRotation(t *testing.T) {
+	store := &MemoryCache{}
+	keys := &rotatingKeys{current: "2024", keys: map[string][]byte{
+		"2024": bytes.Repeat([]byte{1}, 16),
+		"2025": bytes.Repeat([]byte{2}, 32),
+	}}
+	cache := &EncryptedCache{Cache: store, Keys: keys}
+
+	cache.Set("old", []byte("before"), 0)
+	keys.current = "2025"
+	cache.Set("new", []byte("after"), 0)
+
+	for key, want := range map[string]string{"old": "before", "new": "after"} {
+		if got, ok := cache.Get(key); !ok || string(got) != want {
+			t.Errorf("EncryptedCache.Get(%q) = %q, %v, want %q, true", key, got, ok, want)
+		}
+	}
+
+	delete(keys.keys, "2024")
+	if _, ok := cache.Get("old"); ok {
+		t.Errorf("EncryptedCache.Get() with a retired key ok = true, want false")
+	}
+}
@@ -0,0 +1,233 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"sync"
+	"time"
+)
+
+const (
+	DefaultEnrichConcurrency = 8
+	DefaultEnrichTimeout     = 10 * time.Second
+
+	// maxEnrichBody bounds how much of a page is read when looking for its favicon.
+	maxEnrichBody = 64 * 1024
+)
+
+// EnrichOptions controls the local metadata enrichment pass over result URLs.
+type EnrichOptions struct {
+	// Concurrency caps parallel requests. Defaults to DefaultEnrichConcurrency.
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+	// Timeout bounds each URL check. Defaults to DefaultEnrichTimeout.
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// Favicon fetches HTML pages to resolve their favicon. Without it only HEAD requests are made.
+	Favicon bool `json:"favicon,omitempty" yaml:"favicon,omitempty"`
+}
+
+// ResultMetadata is filled in locally by the enrichment pass.
+type ResultMetadata struct {
+	FinalURL    string `json:"final_url,omitempty"`
+	StatusCode  int    `json:"status_code,omitempty"`
+	ContentType string `json:"content_type,omitempty"`
+	FaviconURL  string `json:"favicon_url,omitempty"`
+	// Error describes why the URL could not be reached.
+	Error string `json:"error,omitempty"`
+
+	// hostNotFound records a DNS lookup that found no such host.
+	hostNotFound bool
+}
+
+// Reachable reports whether the URL answered with a non-error status.
+func (m *ResultMetadata) Reachable() bool {
+	return m != nil && m.Error == "" && m.StatusCode > 0 && m.StatusCode < 400
+}
+
+// EnrichResults checks every result URL from this machine in parallel and fills
+// in its Metadata: final redirect URL, HTTP status, content type and favicon.
+// Results are updated in place.
+func (c *Client) EnrichResults(ctx context.Context, results []SearchResult, opts *EnrichOptions) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		for i := range results {
+			results[i].Metadata = &ResultMetadata{Error: err.Error()}
+		}
+		return
+	}
+	defer done()
+
+	if opts == nil {
+		opts = &EnrichOptions{}
+	}
+	concurrency := defaultInt(opts.Concurrency, DefaultEnrichConcurrency)
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultEnrichTimeout
+	}
+
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i := range results {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				results[i].Metadata = &ResultMetadata{Error: ctx.Err().Error()}
+				return
+			}
+			defer func() { <-sem }()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			results[i].Metadata = c.probeURL(checkCtx, results[i].URL, opts.Favicon)
+		}()
+	}
+	wg.Wait()
+}
+
+// IsDead reports whether the URL is definitively gone: it answered 404 or 410
+// or its host does not exist. A timeout or other failed check is not enough.
+func (m *ResultMetadata) IsDead() bool {
+	return m != nil && (m.hostNotFound || m.StatusCode == http.StatusNotFound || m.StatusCode == http.StatusGone)
+}
+
+// pruneDeadLinks drops enriched results whose URLs are dead.
+func pruneDeadLinks(results []SearchResult) []SearchResult {
+	return slices.DeleteFunc(results, func(r SearchResult) bool {
+		return r.Metadata.IsDead()
+	})
+}
+
+func (c *Client) probeURL(ctx context.Context, rawURL string, favicon bool) *ResultMetadata {
+	meta := &ResultMetadata{}
+
+	resp, err := c.probeHeadOrGet(ctx, rawURL)
+	if err != nil {
+		var dnsErr *net.DNSError
+		meta.hostNotFound = errors.As(err, &dnsErr) && dnsErr.IsNotFound
+		meta.Error = err.Error()
+		return meta
+	}
+	// A GET fallback's body is read for the favicon below.
+	defer resp.Body.Close()
+
+	meta.FinalURL = resp.Request.URL.String()
+	meta.StatusCode = resp.StatusCode
+	meta.ContentType = resp.Header.Get("Content-Type")
+
+	if !favicon || !meta.Reachable() {
+		return meta
+	}
+
+	meta.FaviconURL = originURL(resp.Request.URL, "/favicon.ico")
+	if mediaType, _, _ := mime.ParseMediaType(meta.ContentType); mediaType != "text/html" {
+		return meta
+	}
+
+	if resp.Request.Method == http.MethodHead {
+		// Free the HEAD's Politeness slot before taking another for the GET.
+		resp.Body.Close()
+		resp, err = c.probe(ctx, http.MethodGet, meta.FinalURL)
+		if err != nil {
+			return meta
+		}
+		defer resp.Body.Close()
+	}
+
+	head, _ := io.ReadAll(io.LimitReader(resp.Body, maxEnrichBody))
+	if href := findFavicon(head); href != "" {
+		if ref, err := resp.Request.URL.Parse(href); err == nil {
+			meta.FaviconURL = ref.String()
+		}
+	}
+	return meta
+}
+
+// probe fetches rawURL directly rather than through the API, for link checks,
+// robots.txt and local extraction. Each fetch is audited like an API call,
+// takes a Politeness slot for its host and stays in flight for Close until
+// its body is closed; the slot is held as long.
+func (c *Client) probe(ctx context.Context, method, rawURL string) (*http.Response, error) {
+	ctx, end, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	release, err := c.limiter.acquire(ctx, rawURL)
+	if err != nil {
+		end()
+		return nil, err
+	}
+	done := func() {
+		release()
+		end()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, rawURL, nil)
+	if err != nil {
+		done()
+		return nil, err
+	}
+	req.Header.Set("User-Agent", ClientSource)
+	resp, err := c.httpClient.Do(req)
+
+	if c.auditSink != nil {
+		ctx, requestID := ensureRequestID(ctx)
+		record := c.newAuditRecord(ctx, method, "", requestID, nil, nil)
+		record.URLs = []string{rawURL}
+		record.Attempts = 1
+		if resp != nil {
+			record.StatusCode = resp.StatusCode
+		}
+		if aerr := c.audit(ctx, record, nil, err); aerr != nil {
+			if resp != nil {
+				resp.Body.Close()
+			}
+			resp, err = nil, aerr
+		}
+	}
+	if err != nil {
+		done()
+		return nil, err
+	}
+	resp.Body = &inflightBody{ReadCloser: resp.Body, done: sync.OnceFunc(done)}
+	return resp, nil
+}
+
+// probeHeadOrGet probes rawURL with HEAD, falling back to GET for servers
+// that do not implement HEAD. The caller must close the body.
+func (c *Client) probeHeadOrGet(ctx context.Context, rawURL string) (*http.Response, error) {
+	resp, err := c.probe(ctx, http.MethodHead, rawURL)
+	if err == nil && (resp.StatusCode == http.StatusMethodNotAllowed || resp.StatusCode == http.StatusNotImplemented) {
+		resp.Body.Close()
+		resp, err = c.probe(ctx, http.MethodGet, rawURL)
+	}
+	return resp, err
+}
+
+var (
+	iconLinkPattern = regexp.MustCompile(`(?is)<link\b[^>]*\brel\s*=\s*["']?(?:shortcut\s+)?icon["'\s>][^>]*>`)
+	hrefPattern     = regexp.MustCompile(`(?is)\bhref\s*=\s*["']?([^"'\s>]+)`)
+)
+
+func findFavicon(html []byte) string {
+	link := iconLinkPattern.Find(html)
+	if link == nil {
+		return ""
+	}
+	if m := hrefPattern.FindSubmatch(link); m != nil {
+		return string(m[1])
+	}
+	return ""
+}
+
+func originURL(u *url.URL, path string) string {
+	return (&url.URL{Scheme: u.Scheme, Host: u.Host, Path: path}).String()
+}
@@ -0,0 +1,147 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnrichResults(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/old":
+			http.Redirect(w, r, "/page", http.StatusMovedPermanently)
+		case "/page":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			w.Write([]byte(`<html><head><link rel="icon" href="/static/icon.png"></head></html>`))
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.Header().Set("Content-Type", "application/pdf")
+		case "/html-no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusNotImplemented)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html")
+			w.Write([]byte(`<html><head><link rel="shortcut icon" href="/fav.svg"></head></html>`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+
+	client := New("tvly-test-key", nil)
+	results := []SearchResult{
+		{URL: site.URL + "/old"},
+		{URL: site.URL + "/no-head"},
+		{URL: site.URL + "/gone"},
+		{URL: site.URL + "/html-no-head"},
+	}
+
+	client.EnrichResults(context.Background(), results, &EnrichOptions{Favicon: true})
+
+	page := results[0].Metadata
+	if page.FinalURL != site.URL+"/page" || page.StatusCode != http.StatusOK {
+		t.Errorf("redirected result metadata = %+v", page)
+	}
+	if page.FaviconURL != site.URL+"/static/icon.png" {
+		t.Errorf("FaviconURL = %v, want %v", page.FaviconURL, site.URL+"/static/icon.png")
+	}
+
+	if pdf := results[1].Metadata; pdf.ContentType != "application/pdf" || !pdf.Reachable() {
+		t.Errorf("HEAD fallback metadata = %+v", pdf)
+	}
+
+	if gone := results[2].Metadata; gone.StatusCode != http.StatusNotFound || gone.Reachable() {
+		t.Errorf("missing page metadata = %+v", gone)
+	}
+
+	if html := results[3].Metadata; html.FaviconURL != site.URL+"/fav.svg" {
+		t.Errorf("HEAD fallback FaviconURL = %v, want %v", html.FaviconURL, site.URL+"/fav.svg")
+	}
+}
+
+func TestVerifyLinks(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/live":
+			w.WriteHeader(http.StatusOK)
+		case "/forbidden":
+			w.WriteHeader(http.StatusForbidden)
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/slow":
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer site.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "` + site.URL + `/live"},
+			{"url": "` + site.URL + `/missing"},
+			{"url": "` + site.URL + `/forbidden"},
+			{"url": "` + site.URL + `/gone"},
+			{"url": "` + site.URL + `/slow"},
+			{"url": "http://127.0.0.1:1/unreachable"},
+			{"url": "http://no-such-host.invalid/"}
+		]}`))
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:    api.URL,
+		HTTPClient: &http.Client{Transport: noSuchHostTransport{http.DefaultTransport}},
+	})
+
+	result, err := client.Search(context.Background(), "test", &SearchOptions{
+		VerifyLinks: &EnrichOptions{Concurrency: 2, Timeout: 100 * time.Millisecond},
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	var kept []string
+	for _, r := range result.Results {
+		kept = append(kept, r.URL)
+	}
+	want := []string{site.URL + "/live", site.URL + "/forbidden", site.URL + "/slow", "http://127.0.0.1:1/unreachable"}
+	if !slices.Equal(kept, want) {
+		t.Errorf("Search() kept %v, want %v", kept, want)
+	}
+
+	_, err = client.Search(context.Background(), "test", &SearchOptions{
+		Enrich:      &EnrichOptions{Favicon: true},
+		VerifyLinks: &EnrichOptions{},
+	})
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("Search() with Enrich and VerifyLinks error = %v, want bad request", err)
+	}
+}
+
+// noSuchHostTransport fails every request to a .invalid host with a DNS
+// not-found error, without depending on the sandbox resolver.
+type noSuchHostTransport struct{ next http.RoundTripper }
+
+func (t noSuchHostTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if strings.HasSuffix(req.URL.Hostname(), ".invalid") {
+		return nil, &net.DNSError{Err: "no such host", Name: req.URL.Hostname(), IsNotFound: true}
+	}
+	return t.next.RoundTrip(req)
+}
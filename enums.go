@@ -0,0 +1,119 @@
+package tavily
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+var (
+	searchDepths  = []SearchDepth{SearchDepthBasic, SearchDepthAdvanced}
+	extractDepths = []ExtractDepth{ExtractDepthBasic, ExtractDepthAdvanced}
+	topics        = []Topic{TopicGeneral, TopicNews, TopicFinance}
+	timeRanges    = []TimeRange{
+		TimeRangeDay, TimeRangeWeek, TimeRangeMonth, TimeRangeYear,
+		TimeRangeD, TimeRangeW, TimeRangeM, TimeRangeY,
+	}
+	formats         = []Format{FormatText, FormatMarkdown}
+	crawlCategories = []CrawlCategory{
+		CategoryDocumentation, CategoryBlog, CategoryBlogs, CategoryCommunity, CategoryAbout,
+		CategoryContact, CategoryPrivacy, CategoryTerms, CategoryStatus, CategoryPricing,
+		CategoryEnterprise, CategoryCareers, CategoryECommerce, CategoryAuthentication,
+		CategoryDeveloper, CategoryDevelopers, CategorySolutions, CategoryPartners,
+		CategoryDownloads, CategoryMedia, CategoryEvents, CategoryPeople,
+	}
+)
+
+// IsValid reports whether d is one of the search depths accepted by the API.
+func (d SearchDepth) IsValid() bool { return slices.Contains(searchDepths, d) }
+
+// Values returns every search depth accepted by the API.
+func (SearchDepth) Values() []SearchDepth { return slices.Clone(searchDepths) }
+
+// ParseSearchDepth parses a search depth case-insensitively.
+func ParseSearchDepth(s string) (SearchDepth, error) {
+	return parseEnum("search depth", s, searchDepths)
+}
+
+// IsValid reports whether d is one of the extract depths accepted by the API.
+func (d ExtractDepth) IsValid() bool { return slices.Contains(extractDepths, d) }
+
+// Values returns every extract depth accepted by the API.
+func (ExtractDepth) Values() []ExtractDepth { return slices.Clone(extractDepths) }
+
+// ParseExtractDepth parses an extract depth case-insensitively.
+func ParseExtractDepth(s string) (ExtractDepth, error) {
+	return parseEnum("extract depth", s, extractDepths)
+}
+
+// IsValid reports whether t is one of the topics accepted by the API.
+func (t Topic) IsValid() bool { return slices.Contains(topics, t) }
+
+// Values returns every topic accepted by the API.
+func (Topic) Values() []Topic { return slices.Clone(topics) }
+
+// ParseTopic parses a topic case-insensitively.
+func ParseTopic(s string) (Topic, error) {
+	return parseEnum("topic", s, topics)
+}
+
+// IsValid reports whether r is one of the time ranges accepted by the API.
+func (r TimeRange) IsValid() bool { return slices.Contains(timeRanges, r) }
+
+// Values returns every time range accepted by the API, including the short aliases.
+func (TimeRange) Values() []TimeRange { return slices.Clone(timeRanges) }
+
+// ParseTimeRange parses a time range case-insensitively.
+func ParseTimeRange(s string) (TimeRange, error) {
+	return parseEnum("time range", s, timeRanges)
+}
+
+// IsValid reports whether f is one of the content formats accepted by the API.
+func (f Format) IsValid() bool { return slices.Contains(formats, f) }
+
+// Values returns every content format accepted by the API.
+func (Format) Values() []Format { return slices.Clone(formats) }
+
+// ParseFormat parses a content format case-insensitively.
+func ParseFormat(s string) (Format, error) {
+	return parseEnum("format", s, formats)
+}
+
+// IsValid reports whether c is one of the categories accepted by the API.
+func (c CrawlCategory) IsValid() bool { return slices.Contains(crawlCategories, c) }
+
+// Values returns every crawl category accepted by the API.
+func (CrawlCategory) Values() []CrawlCategory { return slices.Clone(crawlCategories) }
+
+// ParseCrawlCategory parses a crawl category case-insensitively.
+func ParseCrawlCategory(s string) (CrawlCategory, error) {
+	return parseEnum("crawl category", s, crawlCategories)
+}
+
+// IsValid reports whether c is one of the countries accepted by the API.
+func (c Country) IsValid() bool { return slices.Contains(countries, c) }
+
+// Values returns every country accepted by the API.
+func (Country) Values() []Country { return slices.Clone(countries) }
+
+// ParseCountry parses a country name case-insensitively, treating "_" and "-" as spaces.
+func ParseCountry(s string) (Country, error) {
+	s = strings.NewReplacer("_", " ", "-", " ").Replace(s)
+	return parseEnum("country", strings.Join(strings.Fields(s), " "), countries)
+}
+
+func parseEnum[T ~string](kind, s string, values []T) (T, error) {
+	s = strings.TrimSpace(s)
+	for _, v := range values {
+		if strings.EqualFold(string(v), s) {
+			return v, nil
+		}
+	}
+
+	names := make([]string, len(values))
+	for i, v := range values {
+		names[i] = string(v)
+	}
+	var zero T
+	return zero, fmt.Errorf("invalid %s %q (valid: %s)", kind, s, strings.Join(names, ", "))
+}
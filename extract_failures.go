@@ -0,0 +1,126 @@
+package tavily
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FailureReason is the machine-readable cause of a failed extraction, parsed
+// from the API's free-form error string.
+type FailureReason string
+
+const (
+	ReasonForbidden   FailureReason = "forbidden"
+	ReasonNotFound    FailureReason = "not_found"
+	ReasonPaywall     FailureReason = "paywall"
+	ReasonTimeout     FailureReason = "timeout"
+	ReasonTooLarge    FailureReason = "too_large"
+	ReasonRateLimited FailureReason = "rate_limited"
+	ReasonUnsupported FailureReason = "unsupported"
+	ReasonUnknown     FailureReason = "unknown"
+)
+
+// failureReasonPatterns are checked in order against the lowercased error string.
+var failureReasonPatterns = []struct {
+	reason   FailureReason
+	patterns []string
+}{
+	{ReasonPaywall, []string{"paywall", "subscription", "subscribe"}},
+	{ReasonForbidden, []string{"403", "forbidden", "access denied", "blocked"}},
+	{ReasonNotFound, []string{"404", "not found"}},
+	{ReasonRateLimited, []string{"429", "too many requests", "rate limit"}},
+	{ReasonTooLarge, []string{"413", "too large", "exceeds maximum", "size limit"}},
+	{ReasonTimeout, []string{"timeout", "timed out", "deadline"}},
+	{ReasonUnsupported, []string{"unsupported", "binary", "skipped", "content type"}},
+}
+
+// Permanent reports whether retrying the URL is pointless: access is denied,
+// the page is missing or too large, or its content cannot be extracted.
+// Timeouts, rate limits and unknown failures may succeed on retry.
+func (r FailureReason) Permanent() bool {
+	switch r {
+	case ReasonForbidden, ReasonNotFound, ReasonPaywall, ReasonTooLarge, ReasonUnsupported:
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseFailureReason classifies an extract failure message.
+func ParseFailureReason(message string) FailureReason {
+	message = strings.ToLower(message)
+	for _, p := range failureReasonPatterns {
+		for _, pattern := range p.patterns {
+			if strings.Contains(message, pattern) {
+				return p.reason
+			}
+		}
+	}
+	return ReasonUnknown
+}
+
+// PartialSuccessError reports that some URLs of an extract call failed.
+type PartialSuccessError struct {
+	Succeeded int
+	Failures  []ExtractFailedResult
+}
+
+func (e *PartialSuccessError) Error() string {
+	byReason := e.ByReason()
+	reasons := make([]string, 0, len(byReason))
+	for reason, urls := range byReason {
+		reasons = append(reasons, fmt.Sprintf("%s: %d", reason, len(urls)))
+	}
+	slices.Sort(reasons)
+	return fmt.Sprintf("extract partially failed: %d of %d URLs failed (%s)",
+		len(e.Failures), e.Succeeded+len(e.Failures), strings.Join(reasons, ", "))
+}
+
+// ByReason groups the failed URLs by cause.
+func (e *PartialSuccessError) ByReason() map[FailureReason][]string {
+	grouped := make(map[FailureReason][]string)
+	for _, f := range e.Failures {
+		grouped[f.Reason] = append(grouped[f.Reason], f.URL)
+	}
+	return grouped
+}
+
+// Err returns a *PartialSuccessError when any URL failed, or nil. Callers that
+// treat partial results as an error can write:
+//
+//	resp, err := client.Extract(ctx, urls, nil)
+//	if err == nil {
+//		err = resp.Err()
+//	}
+func (r *ExtractResponse) Err() error {
+	if len(r.FailedResults) == 0 {
+		return nil
+	}
+	return &PartialSuccessError{Succeeded: len(r.Results), Failures: r.FailedResults}
+}
+
+// RetryableURLs returns the failed URLs whose failure may be transient, for
+// passing to a follow-up Extract call.
+func (r *ExtractResponse) RetryableURLs() []string {
+	var urls []string
+	for _, f := range r.FailedResults {
+		reason := f.Reason
+		if reason == "" {
+			reason = ParseFailureReason(f.Error)
+		}
+		if !reason.Permanent() {
+			urls = append(urls, f.URL)
+		}
+	}
+	return urls
+}
+
+// classifyFailures fills in the Reason of every failed result.
+func classifyFailures(failed []ExtractFailedResult) {
+	for i := range failed {
+		if failed[i].Reason == "" {
+			failed[i].Reason = ParseFailureReason(failed[i].Error)
+		}
+	}
+}
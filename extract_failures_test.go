@@ -0,0 +1,78 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseFailureReason(t *testing.T) {
+	tests := []struct {
+		message string
+		want    FailureReason
+	}{
+		{message: "Failed to fetch url: 403 Client Error: Forbidden", want: ReasonForbidden},
+		{message: "HTTP 404 Not Found", want: ReasonNotFound},
+		{message: "Content is behind a paywall", want: ReasonPaywall},
+		{message: "Request timed out after 30s", want: ReasonTimeout},
+		{message: "429 Too Many Requests", want: ReasonRateLimited},
+		{message: "Document exceeds maximum size", want: ReasonTooLarge},
+		{message: "something odd happened", want: ReasonUnknown},
+	}
+	for _, tt := range tests {
+		if got := ParseFailureReason(tt.message); got != tt.want {
+			t.Errorf("ParseFailureReason(%q) = %v, want %v", tt.message, got, tt.want)
+		}
+	}
+}
+
+func TestExtractPartialSuccess(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{
+			"results": [{"url": "https://example.com/ok", "raw_content": "ok"}],
+			"failed_results": [
+				{"url": "https://example.com/private", "error": "403 Forbidden"},
+				{"url": "https://example.com/slow", "error": "timeout"}
+			]
+		}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	urls := []string{"https://example.com/ok", "https://example.com/private", "https://example.com/slow"}
+	resp, err := client.Extract(context.Background(), urls, nil)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if resp.FailedResults[0].Reason != ReasonForbidden || resp.FailedResults[1].Reason != ReasonTimeout {
+		t.Errorf("failure reasons = %v, %v, want forbidden, timeout",
+			resp.FailedResults[0].Reason, resp.FailedResults[1].Reason)
+	}
+
+	var partial *PartialSuccessError
+	if err := resp.Err(); !errors.As(err, &partial) {
+		t.Fatalf("Err() = %v, want *PartialSuccessError", err)
+	}
+	if got := partial.ByReason()[ReasonForbidden]; len(got) != 1 || got[0] != urls[1] {
+		t.Errorf("ByReason()[forbidden] = %v, want [%s]", got, urls[1])
+	}
+	if msg := partial.Error(); !strings.Contains(msg, "2 of 3 URLs failed") {
+		t.Errorf("Error() = %q, want failure count", msg)
+	}
+
+	if got := resp.RetryableURLs(); len(got) != 1 || got[0] != urls[2] {
+		t.Errorf("RetryableURLs() = %v, want [%s]", got, urls[2])
+	}
+
+	if err := (&ExtractResponse{}).Err(); err != nil {
+		t.Errorf("Err() without failures = %v, want nil", err)
+	}
+}
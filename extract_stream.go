@@ -0,0 +1,125 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+)
+
+// MaxExtractURLs is the number of URLs the extract endpoint accepts per request.
+const MaxExtractURLs = 20
+
+// StreamFormat selects how ExtractToWriter serializes documents.
+type StreamFormat string
+
+const (
+	// StreamJSONL writes one ExtractResult JSON object per line.
+	StreamJSONL StreamFormat = "jsonl"
+	// StreamMarkdown writes each document under a "# <url>" heading, separated
+	// by horizontal rules.
+	StreamMarkdown StreamFormat = "markdown"
+)
+
+// ExtractStreamResult summarizes an ExtractToWriter call.
+type ExtractStreamResult struct {
+	// Written is the number of documents written.
+	Written int
+	// FailedResults lists the URLs that could not be extracted.
+	FailedResults []ExtractFailedResult
+	// Unhealthy lists the URLs left out by ExtractOptions.HealthCheck.
+	Unhealthy []ExtractFailedResult
+	// Redirects maps requested URLs to the final URLs they were extracted
+	// under when ExtractOptions.ResolveRedirects is set.
+	Redirects map[string]string
+	// Meta aggregates the metadata of every batch request.
+	Meta ResponseMeta
+}
+
+// ExtractToWriter extracts urls in batches of MaxExtractURLs and streams each
+// document to w as its batch arrives, so raw content of large URL lists is
+// never held in memory at once. Writing stops at the first failed batch or
+// write error; documents already written stay written.
+func (c *Client) ExtractToWriter(ctx context.Context, urls []string, opts *ExtractOptions, w io.Writer, format StreamFormat) (*ExtractStreamResult, error) {
+	if format != StreamJSONL && format != StreamMarkdown {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    fmt.Sprintf("unknown stream format %q", format),
+		}
+	}
+	if len(urls) == 0 {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "at least one URL is required",
+		}
+	}
+
+	var attribution *AttributionPolicy
+	if opts != nil {
+		attribution = opts.Attribution
+	}
+
+	summary := &ExtractStreamResult{}
+	enc := json.NewEncoder(w)
+	for start := 0; start < len(urls); start += MaxExtractURLs {
+		batch := urls[start:min(start+MaxExtractURLs, len(urls))]
+		resp, err := c.Extract(ctx, batch, opts)
+		if err != nil {
+			return summary, err
+		}
+		if start == 0 {
+			summary.Meta = resp.Meta
+		} else {
+			summary.Meta.add(resp.Meta)
+		}
+		summary.FailedResults = append(summary.FailedResults, resp.FailedResults...)
+		summary.Unhealthy = append(summary.Unhealthy, resp.Unhealthy...)
+		if len(resp.Redirects) > 0 && summary.Redirects == nil {
+			summary.Redirects = make(map[string]string)
+		}
+		maps.Copy(summary.Redirects, resp.Redirects)
+
+		for _, result := range resp.Results {
+			var attr *Attribution
+			if attribution != nil {
+				a := attribution.Attribute(result.URL, resp.Meta.RetrievedAt, resp.Meta.RequestID)
+				attr = &a
+			}
+			if format == StreamJSONL {
+				err = enc.Encode(attributedExtractResult{ExtractResult: result, Attribution: attr})
+			} else {
+				err = writeMarkdownDocument(w, result, attr, summary.Written == 0)
+			}
+			if err != nil {
+				return summary, fmt.Errorf("failed to write %s: %w", result.URL, err)
+			}
+			summary.Written++
+		}
+	}
+	return summary, nil
+}
+
+// attributedExtractResult is the JSONL line of an ExtractToWriter document.
+type attributedExtractResult struct {
+	ExtractResult
+	Attribution *Attribution `json:"attribution,omitempty"`
+}
+
+func writeMarkdownDocument(w io.Writer, result ExtractResult, attr *Attribution, first bool) error {
+	if !first {
+		if _, err := io.WriteString(w, "\n---\n\n"); err != nil {
+			return err
+		}
+	}
+	if _, err := fmt.Fprintf(w, "# %s\n\n", result.URL); err != nil {
+		return err
+	}
+	if attr != nil {
+		if _, err := fmt.Fprintf(w, "> %s\n\n", attr); err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%s\n", result.RawContent)
+	return err
+}
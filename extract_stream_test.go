@@ -0,0 +1,123 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestExtractToWriter(t *testing.T) {
+	var batches []int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ExtractRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		batches = append(batches, len(req.URLs))
+
+		resp := ExtractResponse{}
+		for _, u := range req.URLs {
+			if strings.HasSuffix(u, "/broken") {
+				resp.FailedResults = append(resp.FailedResults, ExtractFailedResult{URL: u, Error: "timeout"})
+				continue
+			}
+			resp.Results = append(resp.Results, ExtractResult{URL: u, RawContent: "content of " + u})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+
+	urls := make([]string, 0, 25)
+	for i := range 24 {
+		urls = append(urls, fmt.Sprintf("https://example.com/%d", i))
+	}
+	urls = append(urls, "https://example.com/broken")
+
+	var out strings.Builder
+	summary, err := client.ExtractToWriter(context.Background(), urls, nil, &out, StreamJSONL)
+	if err != nil {
+		t.Fatalf("ExtractToWriter() error = %v", err)
+	}
+	if len(batches) != 2 || batches[0] != MaxExtractURLs || batches[1] != 5 {
+		t.Errorf("batch sizes = %v, want [20 5]", batches)
+	}
+	lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+	if summary.Written != 24 || len(lines) != 24 || len(summary.FailedResults) != 1 || summary.Meta.Attempts != 2 {
+		t.Errorf("written = %d, lines = %d, failed = %d, attempts = %d, want 24, 24, 1, 2",
+			summary.Written, len(lines), len(summary.FailedResults), summary.Meta.Attempts)
+	}
+	var first ExtractResult
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil || first.URL != urls[0] {
+		t.Errorf("first line = %q, want JSON for %s", lines[0], urls[0])
+	}
+
+	out.Reset()
+	if _, err := client.ExtractToWriter(context.Background(), urls[:2], nil, &out, StreamMarkdown); err != nil {
+		t.Fatalf("ExtractToWriter() error = %v", err)
+	}
+	want := "# https://example.com/0\n\ncontent of https://example.com/0\n\n---\n\n# https://example.com/1\n\ncontent of https://example.com/1\n"
+	if out.String() != want {
+		t.Errorf("markdown output = %q, want %q", out.String(), want)
+	}
+}
+
+func TestExtractToWriterReports(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if id, ok := strings.CutPrefix(r.URL.Path, "/s/"); ok {
+			http.Redirect(w, r, "/page/"+id, http.StatusMovedPermanently)
+			return
+		}
+		if r.URL.Path == "/gone" {
+			w.WriteHeader(http.StatusGone)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+	}))
+	defer site.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ExtractRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		resp := ExtractResponse{}
+		for _, u := range req.URLs {
+			resp.Results = append(resp.Results, ExtractResult{URL: u, RawContent: "content of " + u})
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(resp)
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: api.URL})
+
+	urls := make([]string, 0, MaxExtractURLs+2)
+	for i := range MaxExtractURLs + 1 {
+		urls = append(urls, fmt.Sprintf("%s/s/%d", site.URL, i))
+	}
+	urls = append(urls, site.URL+"/gone")
+
+	var out strings.Builder
+	summary, err := client.ExtractToWriter(context.Background(), urls, &ExtractOptions{
+		ResolveRedirects: true,
+		HealthCheck:      &HealthCheckOptions{},
+	}, &out, StreamJSONL)
+	if err != nil {
+		t.Fatalf("ExtractToWriter() error = %v", err)
+	}
+	if len(summary.Redirects) != MaxExtractURLs+1 || summary.Redirects[urls[MaxExtractURLs]] != fmt.Sprintf("%s/page/%d", site.URL, MaxExtractURLs) {
+		t.Errorf("Redirects = %v, want every short link from both batches", summary.Redirects)
+	}
+	if len(summary.Unhealthy) != 1 || summary.Unhealthy[0].URL != site.URL+"/gone" {
+		t.Errorf("Unhealthy = %+v, want %s from the second batch", summary.Unhealthy, site.URL+"/gone")
+	}
+	if summary.Written != MaxExtractURLs+1 {
+		t.Errorf("written = %d, want %d", summary.Written, MaxExtractURLs+1)
+	}
+}
@@ -0,0 +1,124 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultFailoverThreshold is the number of consecutive 5xx responses after
+	// which an endpoint is considered unhealthy.
+	DefaultFailoverThreshold = 3
+	// DefaultFailoverCooldown is how long an unhealthy endpoint is skipped before
+	// it is tried again.
+	DefaultFailoverCooldown = 30 * time.Second
+)
+
+// FailoverPolicy tunes health tracking of Options.BaseURL and
+// Options.FallbackBaseURLs. An unreachable endpoint is marked unhealthy
+// immediately; one returning server errors after FailureThreshold consecutive
+// failures. Unhealthy endpoints are skipped for Cooldown, then tried again.
+type FailoverPolicy struct {
+	// FailureThreshold defaults to DefaultFailoverThreshold.
+	FailureThreshold int
+	// Cooldown defaults to DefaultFailoverCooldown.
+	Cooldown time.Duration
+}
+
+type endpointHealth struct {
+	baseURL   string
+	failures  int
+	downUntil time.Time
+}
+
+// endpointPool tracks the health of the primary and fallback base URLs.
+type endpointPool struct {
+	mu        sync.Mutex
+	endpoints []*endpointHealth
+	threshold int
+	cooldown  time.Duration
+	clock     Clock
+}
+
+func newEndpointPool(baseURLs []string, policy *FailoverPolicy, clock Clock) *endpointPool {
+	p := &endpointPool{
+		threshold: DefaultFailoverThreshold,
+		cooldown:  DefaultFailoverCooldown,
+		clock:     clockOr(clock),
+	}
+	if policy != nil {
+		p.threshold = defaultInt(policy.FailureThreshold, p.threshold)
+		if policy.Cooldown > 0 {
+			p.cooldown = policy.Cooldown
+		}
+	}
+	for _, u := range baseURLs {
+		if u != "" {
+			p.endpoints = append(p.endpoints, &endpointHealth{baseURL: u})
+		}
+	}
+	return p
+}
+
+// pick returns the first healthy endpoint in configuration order. When every
+// endpoint is unhealthy, the one due to recover first is returned.
+func (p *endpointPool) pick() string {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.clock.Now()
+	soonest := p.endpoints[0]
+	for _, e := range p.endpoints {
+		if !now.Before(e.downUntil) {
+			return e.baseURL
+		}
+		if e.downUntil.Before(soonest.downUntil) {
+			soonest = e
+		}
+	}
+	return soonest.baseURL
+}
+
+// report records the outcome of a request to baseURL and reports whether it
+// made the endpoint unhealthy. Rate limits and client errors say nothing about
+// endpoint health and are ignored, as are URLs outside the pool.
+func (p *endpointPool) report(ctx context.Context, baseURL string, resp *http.Response, err error) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	var e *endpointHealth
+	for _, candidate := range p.endpoints {
+		if candidate.baseURL == baseURL {
+			e = candidate
+		}
+	}
+	if e == nil {
+		return false
+	}
+
+	switch {
+	case err == nil:
+		e.failures = 0
+		e.downUntil = time.Time{}
+		return false
+	case resp == nil:
+		if ctx.Err() != nil || errors.Is(err, context.Canceled) {
+			return false
+		}
+		e.failures = p.threshold
+	case resp.StatusCode >= 500:
+		e.failures++
+	default:
+		return false
+	}
+
+	if e.failures < p.threshold {
+		return false
+	}
+	e.failures = 0
+	e.downUntil = p.clock.Now().Add(p.cooldown)
+	return true
+}
@@ -0,0 +1,96 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestFailover(t *testing.T) {
+	clock := tavilytest.NewFakeClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+	clock.AutoAdvance = true
+
+	primaryStatus := http.StatusServiceUnavailable
+	var primaryCalls, fallbackCalls int
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		primaryCalls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(primaryStatus)
+		w.Write([]byte(`{"query": "primary", "results": []}`))
+	}))
+	defer primary.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fallbackCalls++
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "fallback", "results": []}`))
+	}))
+	defer fallback.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:          primary.URL,
+		FallbackBaseURLs: []string{fallback.URL},
+		Failover:         &FailoverPolicy{FailureThreshold: 2, Cooldown: time.Minute},
+		Retry:            &RetryPolicy{BaseDelay: time.Millisecond},
+		Clock:            clock,
+	})
+	ctx := context.Background()
+
+	// The first 5xx is retried against the primary, the second trips failover.
+	result, err := client.Search(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Query != "fallback" || primaryCalls != 2 || fallbackCalls != 1 {
+		t.Errorf("served by %q after primary = %d, fallback = %d calls, want fallback after 2 and 1",
+			result.Query, primaryCalls, fallbackCalls)
+	}
+
+	// While cooling down the primary is skipped entirely.
+	if _, err := client.Search(ctx, "test", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if primaryCalls != 2 || fallbackCalls != 2 {
+		t.Errorf("during cooldown primary = %d, fallback = %d calls, want 2 and 2", primaryCalls, fallbackCalls)
+	}
+
+	// After the cooldown the recovered primary is preferred again.
+	clock.Advance(2 * time.Minute)
+	primaryStatus = http.StatusOK
+	result, err = client.Search(ctx, "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Query != "primary" {
+		t.Errorf("after recovery served by %q, want %q", result.Query, "primary")
+	}
+}
+
+func TestFailoverUnreachable(t *testing.T) {
+	dead := httptest.NewServer(http.NotFoundHandler())
+	dead.Close()
+	fallback := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "fallback", "results": []}`))
+	}))
+	defer fallback.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:          dead.URL,
+		FallbackBaseURLs: []string{fallback.URL},
+		Retry:            &RetryPolicy{MaxAttempts: 1},
+	})
+
+	result, err := client.Search(context.Background(), "test", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if result.Query != "fallback" || result.Meta.Attempts != 2 {
+		t.Errorf("served by %q in %d attempts, want fallback in 2", result.Query, result.Meta.Attempts)
+	}
+}
@@ -0,0 +1,262 @@
+package tavily
+
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FinanceKind classifies a FinanceMention.
+type FinanceKind string
+
+const (
+	FinanceTicker FinanceKind = "ticker"
+	FinanceDate   FinanceKind = "date"
+	FinanceFigure FinanceKind = "figure"
+)
+
+// FinanceMention is a ticker, date or figure found in text.
+type FinanceMention struct {
+	Kind FinanceKind
+	// Text is the matched span, text[Start:End].
+	Text       string
+	Start, End int
+	// Symbol is a ticker's symbol, e.g. "AAPL".
+	Symbol string
+	// Time is a date's value; quarters resolve to their first day.
+	Time time.Time
+	// Value is a figure's amount, with scale words such as "billion" applied.
+	Value float64
+	// Unit is a figure's currency code, "%" or "bp".
+	Unit string
+}
+
+// FinanceExtractor finds finance mentions in text. Implementations must be
+// safe for concurrent use.
+type FinanceExtractor interface {
+	ExtractFinance(text string) []FinanceMention
+}
+
+// FinanceExtractorFunc adapts a function to FinanceExtractor.
+type FinanceExtractorFunc func(text string) []FinanceMention
+
+// ExtractFinance implements FinanceExtractor.
+func (f FinanceExtractorFunc) ExtractFinance(text string) []FinanceMention { return f(text) }
+
+var (
+	tickerPattern = regexp.MustCompile(`\$([A-Z]{1,5}(?:\.[A-Z])?)\b|\b(?:NASDAQ|NYSE|NYSEARCA|AMEX|LSE|TSX|ASX)\s?:\s?([A-Z]{1,5}(?:\.[A-Z])?)\b`)
+
+	monthPattern   = `(Jan(?:uary)?|Feb(?:ruary)?|Mar(?:ch)?|Apr(?:il)?|May|June?|July?|Aug(?:ust)?|Sept?(?:ember)?|Oct(?:ober)?|Nov(?:ember)?|Dec(?:ember)?)\.?`
+	isoDatePattern = regexp.MustCompile(`\b(\d{4})-(\d{2})-(\d{2})\b`)
+	mdyDatePattern = regexp.MustCompile(`\b` + monthPattern + `\s(\d{1,2}),?\s(\d{4})\b`)
+	dmyDatePattern = regexp.MustCompile(`\b(\d{1,2})\s` + monthPattern + `,?\s(\d{4})\b`)
+	quarterPattern = regexp.MustCompile(`\bQ([1-4])\s?(?:FY\s?)?(\d{4})\b`)
+
+	figurePattern = regexp.MustCompile(`(?i)(?:([$€£¥])|\b(USD|EUR|GBP|JPY|CHF|CAD|AUD|CNY)\s?)(\d[\d,]*(?:\.\d+)?)(?:\s?(trillion|billion|million|thousand|tn|bn|mn|m|k)\b)?|([-+]?\d+(?:\.\d+)?)\s?(%|percent\b|bps\b|basis points\b)`)
+)
+
+var currencySymbols = map[string]string{"$": "USD", "€": "EUR", "£": "GBP", "¥": "JPY"}
+
+var figureScales = map[string]float64{
+	"trillion": 1e12, "tn": 1e12,
+	"billion": 1e9, "bn": 1e9,
+	"million": 1e6, "mn": 1e6, "m": 1e6,
+	"thousand": 1e3, "k": 1e3,
+}
+
+// Built-in extractors, used by a FinanceScanner without Extractors.
+var (
+	// TickerExtractor finds cashtags such as "$AAPL" and exchange-qualified
+	// symbols such as "NASDAQ: AAPL".
+	TickerExtractor FinanceExtractor = FinanceExtractorFunc(extractTickers)
+	// DateExtractor finds ISO dates, dates such as "March 19, 2025" or
+	// "19 Mar 2025", and quarters such as "Q3 2024".
+	DateExtractor FinanceExtractor = FinanceExtractorFunc(extractDates)
+	// FigureExtractor finds currency amounts such as "$4.2 billion" or
+	// "EUR 300m", percentages and basis points.
+	FigureExtractor FinanceExtractor = FinanceExtractorFunc(extractFigures)
+)
+
+func extractTickers(text string) []FinanceMention {
+	var mentions []FinanceMention
+	for _, m := range tickerPattern.FindAllStringSubmatchIndex(text, -1) {
+		symbol := submatch(text, m, 1)
+		if symbol == "" {
+			symbol = submatch(text, m, 2)
+		}
+		mentions = append(mentions, FinanceMention{
+			Kind: FinanceTicker, Text: text[m[0]:m[1]], Start: m[0], End: m[1], Symbol: symbol,
+		})
+	}
+	return mentions
+}
+
+func extractDates(text string) []FinanceMention {
+	var mentions []FinanceMention
+	add := func(m []int, year, month, day string) {
+		y, _ := strconv.Atoi(year)
+		d, _ := strconv.Atoi(day)
+		mo := monthNumber(month)
+		t := time.Date(y, time.Month(mo), d, 0, 0, 0, 0, time.UTC)
+		// time.Date normalizes out-of-range days; reject them instead.
+		if mo == 0 || t.Day() != d || t.Month() != time.Month(mo) {
+			return
+		}
+		mentions = append(mentions, FinanceMention{
+			Kind: FinanceDate, Text: text[m[0]:m[1]], Start: m[0], End: m[1], Time: t,
+		})
+	}
+	for _, m := range isoDatePattern.FindAllStringSubmatchIndex(text, -1) {
+		add(m, submatch(text, m, 1), submatch(text, m, 2), submatch(text, m, 3))
+	}
+	for _, m := range mdyDatePattern.FindAllStringSubmatchIndex(text, -1) {
+		add(m, submatch(text, m, 3), submatch(text, m, 1), submatch(text, m, 2))
+	}
+	for _, m := range dmyDatePattern.FindAllStringSubmatchIndex(text, -1) {
+		add(m, submatch(text, m, 3), submatch(text, m, 2), submatch(text, m, 1))
+	}
+	for _, m := range quarterPattern.FindAllStringSubmatchIndex(text, -1) {
+		q, _ := strconv.Atoi(submatch(text, m, 1))
+		add(m, submatch(text, m, 2), strconv.Itoa(3*q-2), "1")
+	}
+	slices.SortFunc(mentions, func(a, b FinanceMention) int { return a.Start - b.Start })
+	return mentions
+}
+
+// monthNumber parses a month number or an English month name.
+func monthNumber(month string) int {
+	if n, err := strconv.Atoi(month); err == nil {
+		if n < 1 || n > 12 {
+			return 0
+		}
+		return n
+	}
+	if len(month) < 3 {
+		return 0
+	}
+	prefix := strings.ToLower(month[:3])
+	for m := time.January; m <= time.December; m++ {
+		if strings.ToLower(m.String()[:3]) == prefix {
+			return int(m)
+		}
+	}
+	return 0
+}
+
+func extractFigures(text string) []FinanceMention {
+	var mentions []FinanceMention
+	for _, m := range figurePattern.FindAllStringSubmatchIndex(text, -1) {
+		mention := FinanceMention{Kind: FinanceFigure, Text: text[m[0]:m[1]], Start: m[0], End: m[1]}
+		if amount := submatch(text, m, 3); amount != "" {
+			value, err := strconv.ParseFloat(strings.ReplaceAll(amount, ",", ""), 64)
+			if err != nil {
+				continue
+			}
+			if scale := figureScales[strings.ToLower(submatch(text, m, 4))]; scale != 0 {
+				value *= scale
+			}
+			mention.Value = value
+			mention.Unit = currencySymbols[submatch(text, m, 1)]
+			if mention.Unit == "" {
+				mention.Unit = strings.ToUpper(submatch(text, m, 2))
+			}
+		} else {
+			value, err := strconv.ParseFloat(submatch(text, m, 5), 64)
+			if err != nil {
+				continue
+			}
+			mention.Value = value
+			mention.Unit = "%"
+			if unit := strings.ToLower(submatch(text, m, 6)); unit == "bps" || unit == "basis points" {
+				mention.Unit = "bp"
+			}
+		}
+		mentions = append(mentions, mention)
+	}
+	return mentions
+}
+
+// submatch returns group n of a FindAllStringSubmatchIndex match, or "".
+func submatch(text string, m []int, n int) string {
+	if m[2*n] < 0 {
+		return ""
+	}
+	return text[m[2*n]:m[2*n+1]]
+}
+
+// FinanceScanner finds tickers, dates and figures in result content, for
+// quickly screening finance-topic results before deeper analysis. The zero
+// value uses the built-in extractors.
+type FinanceScanner struct {
+	// Extractors defaults to TickerExtractor, DateExtractor and
+	// FigureExtractor. Where mentions overlap, the earliest extractor listed
+	// wins.
+	Extractors []FinanceExtractor
+}
+
+// Scan returns the mentions in text, in text order.
+func (s *FinanceScanner) Scan(text string) []FinanceMention {
+	extractors := s.Extractors
+	if len(extractors) == 0 {
+		extractors = []FinanceExtractor{TickerExtractor, DateExtractor, FigureExtractor}
+	}
+
+	var found []FinanceMention
+	for _, e := range extractors {
+		for _, m := range e.ExtractFinance(text) {
+			overlaps := slices.ContainsFunc(found, func(f FinanceMention) bool {
+				return m.Start < f.End && f.Start < m.End
+			})
+			if !overlaps && m.Start >= 0 && m.Start < m.End && m.End <= len(text) {
+				found = append(found, m)
+			}
+		}
+	}
+	slices.SortFunc(found, func(a, b FinanceMention) int { return a.Start - b.Start })
+	return found
+}
+
+// FinanceFacts are the mentions found in one search result.
+type FinanceFacts struct {
+	URL      string
+	Mentions []FinanceMention
+}
+
+// Tickers returns the distinct ticker symbols mentioned, in text order.
+func (f FinanceFacts) Tickers() []string {
+	var tickers []string
+	for _, m := range f.Mentions {
+		if m.Kind == FinanceTicker && !slices.Contains(tickers, m.Symbol) {
+			tickers = append(tickers, m.Symbol)
+		}
+	}
+	return tickers
+}
+
+// Of returns the mentions of the given kind.
+func (f FinanceFacts) Of(kind FinanceKind) []FinanceMention {
+	var mentions []FinanceMention
+	for _, m := range f.Mentions {
+		if m.Kind == kind {
+			mentions = append(mentions, m)
+		}
+	}
+	return mentions
+}
+
+// Results scans the title and content of each result, preferring raw content
+// when the search included it. Mention offsets refer to the title and the
+// scanned content joined by a newline.
+func (s *FinanceScanner) Results(results []SearchResult) []FinanceFacts {
+	facts := make([]FinanceFacts, len(results))
+	for i, r := range results {
+		content := r.RawContent
+		if content == "" {
+			content = r.Content
+		}
+		facts[i] = FinanceFacts{URL: r.URL, Mentions: s.Scan(r.Title + "\n" + content)}
+	}
+	return facts
+}
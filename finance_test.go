@@ -0,0 +1,112 @@
+package tavily
+
+import (
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFinanceExtractors(t *testing.T) {
+	tests := []struct {
+		name      string
+		extractor FinanceExtractor
+		text      string
+		want      []FinanceMention
+	}{
+		{
+			name:      "tickers",
+			extractor: TickerExtractor,
+			text:      "Shares of $NVDA and Apple (NASDAQ: AAPL) rose; BRK.B and $5 did not count.",
+			want: []FinanceMention{
+				{Kind: FinanceTicker, Text: "$NVDA", Symbol: "NVDA"},
+				{Kind: FinanceTicker, Text: "NASDAQ: AAPL", Symbol: "AAPL"},
+			},
+		},
+		{
+			name:      "dates",
+			extractor: DateExtractor,
+			text:      "Filed 2025-03-19, reported March 4, 2025 and 5 Sept 2024 for Q3 FY2024; 2025-02-30 is invalid.",
+			want: []FinanceMention{
+				{Kind: FinanceDate, Text: "2025-03-19", Time: time.Date(2025, 3, 19, 0, 0, 0, 0, time.UTC)},
+				{Kind: FinanceDate, Text: "March 4, 2025", Time: time.Date(2025, 3, 4, 0, 0, 0, 0, time.UTC)},
+				{Kind: FinanceDate, Text: "5 Sept 2024", Time: time.Date(2024, 9, 5, 0, 0, 0, 0, time.UTC)},
+				{Kind: FinanceDate, Text: "Q3 FY2024", Time: time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+		{
+			name:      "figures",
+			extractor: FigureExtractor,
+			text:      "Revenue hit $4.2 billion, up 12.5% as margins grew 30 bps; the EUR 300m deal cost €1,250.",
+			want: []FinanceMention{
+				{Kind: FinanceFigure, Text: "$4.2 billion", Value: 4.2e9, Unit: "USD"},
+				{Kind: FinanceFigure, Text: "12.5%", Value: 12.5, Unit: "%"},
+				{Kind: FinanceFigure, Text: "30 bps", Value: 30, Unit: "bp"},
+				{Kind: FinanceFigure, Text: "EUR 300m", Value: 300e6, Unit: "EUR"},
+				{Kind: FinanceFigure, Text: "€1,250", Value: 1250, Unit: "EUR"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.extractor.ExtractFinance(tt.text)
+			if len(got) != len(tt.want) {
+				t.Fatalf("ExtractFinance() = %+v, want %d mentions", got, len(tt.want))
+			}
+			for i, m := range got {
+				if tt.text[m.Start:m.End] != m.Text {
+					t.Errorf("ExtractFinance()[%d] span %q does not match text %q", i, tt.text[m.Start:m.End], m.Text)
+				}
+				m.Start, m.End = 0, 0
+				if m != tt.want[i] {
+					t.Errorf("ExtractFinance()[%d] = %+v, want %+v", i, m, tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestFinanceScanner(t *testing.T) {
+	results := []SearchResult{
+		{URL: "https://example.com/nvda", Title: "$NVDA beats estimates",
+			Content: "NVIDIA (NASDAQ: NVDA) reported $35.1 billion revenue on 2024-11-20, with $NVDA up 3%."},
+		{URL: "https://example.com/raw", Content: "snippet", RawContent: "Alphabet ($GOOGL) fell 2%."},
+		{URL: "https://example.com/none", Title: "Markets", Content: "Quiet day."},
+	}
+
+	var scanner FinanceScanner
+	facts := scanner.Results(results)
+	if len(facts) != 3 {
+		t.Fatalf("Results() = %d facts, want 3", len(facts))
+	}
+	if got := facts[0].Tickers(); !slices.Equal(got, []string{"NVDA"}) {
+		t.Errorf("Tickers() = %v, want [NVDA]", got)
+	}
+	if got := facts[0].Of(FinanceFigure); len(got) != 2 || got[0].Value != 35.1e9 || got[1].Unit != "%" {
+		t.Errorf("Of(FinanceFigure) = %+v", got)
+	}
+	if got := facts[0].Of(FinanceDate); len(got) != 1 || got[0].Text != "2024-11-20" {
+		t.Errorf("Of(FinanceDate) = %+v", got)
+	}
+	if got := facts[1].Tickers(); !slices.Equal(got, []string{"GOOGL"}) {
+		t.Errorf("Tickers() of raw content = %v, want [GOOGL]", got)
+	}
+	if facts[2].Mentions != nil {
+		t.Errorf("Results() mentions = %+v, want none", facts[2].Mentions)
+	}
+
+	// Custom extractors take part in overlap resolution in list order.
+	custom := FinanceExtractorFunc(func(text string) []FinanceMention {
+		i := strings.Index(text, "$GOOGL")
+		if i < 0 {
+			return nil
+		}
+		return []FinanceMention{{Kind: "company", Text: "Alphabet ($GOOGL)", Start: i - 10, End: i + 7}}
+	})
+	scanner.Extractors = []FinanceExtractor{custom, TickerExtractor, FigureExtractor}
+	got := scanner.Scan(results[1].RawContent)
+	if len(got) != 2 || got[0].Kind != "company" || got[1].Text != "2%" {
+		t.Errorf("Scan() = %+v, want the custom mention and the figure", got)
+	}
+}
@@ -0,0 +1,126 @@
+package tavily
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// FollowUpGenerator suggests follow-up queries for a search, e.g. by asking an
+// LLM what a researcher would look up next. Implementations must be safe for
+// concurrent use.
+type FollowUpGenerator interface {
+	FollowUps(ctx context.Context, resp *SearchResponse, max int) ([]string, error)
+}
+
+// FollowUpGeneratorFunc adapts a function to FollowUpGenerator.
+type FollowUpGeneratorFunc func(ctx context.Context, resp *SearchResponse, max int) ([]string, error)
+
+// FollowUps implements FollowUpGenerator.
+func (f FollowUpGeneratorFunc) FollowUps(ctx context.Context, resp *SearchResponse, max int) ([]string, error) {
+	return f(ctx, resp, max)
+}
+
+// FollowUpOptions configures SuggestFollowUps.
+type FollowUpOptions struct {
+	// Max caps the number of suggestions. Defaults to 5.
+	Max int
+	// Generator suggests the queries instead of the keyword heuristic.
+	Generator FollowUpGenerator
+}
+
+// SuggestFollowUps returns follow-up queries for resp, for exploratory
+// research UIs offering the next search. Without a Generator the suggestions
+// are made by FollowUpQueries. Suggestions are trimmed and deduplicated, and
+// the original query is left out. Under PanicContinue a panicking Generator
+// falls back to the heuristic.
+func (c *Client) SuggestFollowUps(ctx context.Context, resp *SearchResponse, opts *FollowUpOptions) ([]string, error) {
+	if opts == nil {
+		opts = &FollowUpOptions{}
+	}
+	limit := defaultInt(opts.Max, 5)
+
+	if opts.Generator != nil {
+		var suggestions []string
+		var err error
+		perr := c.runHook(ctx, "FollowUpGenerator", func() { suggestions, err = opts.Generator.FollowUps(ctx, resp, limit) })
+		switch {
+		case perr != nil && c.abortOnPanic():
+			return nil, perr
+		case perr == nil && err != nil:
+			return nil, fmt.Errorf("failed to generate follow-ups: %w", err)
+		case perr == nil:
+			return cleanFollowUps(resp.Query, suggestions, limit), nil
+		}
+	}
+	return FollowUpQueries(resp, limit), nil
+}
+
+// FollowUpQueries is SuggestFollowUps' keyword heuristic: the query extended
+// by each of the terms that recur across results but are not in the query,
+// most widespread first, up to max suggestions.
+func FollowUpQueries(resp *SearchResponse, max int) []string {
+	asked := make(map[string]bool)
+	for _, term := range keyTerms(resp.Query) {
+		asked[term] = true
+	}
+
+	type candidate struct {
+		term  string
+		docs  int
+		score float64
+	}
+	var candidates []*candidate
+	index := make(map[string]*candidate)
+	for _, r := range resp.Results {
+		for _, term := range keyTerms(r.Title + " " + r.Content) {
+			if asked[term] || chatFillers[term] {
+				continue
+			}
+			c, ok := index[term]
+			if !ok {
+				c = &candidate{term: term}
+				index[term] = c
+				candidates = append(candidates, c)
+			}
+			c.docs++
+			c.score += r.Score
+		}
+	}
+
+	// With several results, a term from only one of them is too narrow.
+	minDocs := min(len(resp.Results), 2)
+	candidates = slices.DeleteFunc(candidates, func(c *candidate) bool { return c.docs < minDocs })
+	slices.SortStableFunc(candidates, func(a, b *candidate) int {
+		if c := cmp.Compare(b.docs, a.docs); c != 0 {
+			return c
+		}
+		return cmp.Compare(b.score, a.score)
+	})
+
+	suggestions := make([]string, 0, min(len(candidates), max))
+	for _, c := range candidates[:min(len(candidates), max)] {
+		suggestions = append(suggestions, strings.TrimSpace(resp.Query+" "+c.term))
+	}
+	return suggestions
+}
+
+// cleanFollowUps trims suggestions and drops empty ones, repeats and the query.
+func cleanFollowUps(query string, suggestions []string, max int) []string {
+	var cleaned []string
+	for _, s := range suggestions {
+		s = strings.Join(strings.Fields(s), " ")
+		if s == "" || strings.EqualFold(s, strings.TrimSpace(query)) || slices.ContainsFunc(cleaned, func(c string) bool {
+			return strings.EqualFold(c, s)
+		}) {
+			continue
+		}
+		if len(cleaned) == max {
+			break
+		}
+		cleaned = append(cleaned, s)
+	}
+	return cleaned
+}
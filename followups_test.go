@@ -0,0 +1,88 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestFollowUpQueries(t *testing.T) {
+	resp := &SearchResponse{
+		Query: "rust async runtime",
+		Results: []SearchResult{
+			{Title: "Tokio tutorial", Content: "Tokio is an async runtime with a work-stealing scheduler.", Score: 0.9},
+			{Title: "Comparing runtimes", Content: "Tokio and smol differ in scheduler design.", Score: 0.8},
+			{Title: "Async Rust book", Content: "Executors, futures and the smol crate.", Score: 0.5},
+		},
+	}
+
+	want := []string{"rust async runtime tokio", "rust async runtime scheduler", "rust async runtime smol"}
+	if got := FollowUpQueries(resp, 5); !slices.Equal(got, want) {
+		t.Errorf("FollowUpQueries() = %q, want %q", got, want)
+	}
+	if got := FollowUpQueries(resp, 1); !slices.Equal(got, want[:1]) {
+		t.Errorf("FollowUpQueries(1) = %q, want %q", got, want[:1])
+	}
+	if got := FollowUpQueries(&SearchResponse{Query: "q"}, 5); len(got) != 0 {
+		t.Errorf("FollowUpQueries() without results = %q, want none", got)
+	}
+}
+
+func TestSuggestFollowUps(t *testing.T) {
+	resp := &SearchResponse{
+		Query: "go generics",
+		Results: []SearchResult{
+			{Content: "Type parameters and constraints.", Score: 0.9},
+			{Content: "Constraints in practice.", Score: 0.7},
+		},
+	}
+	errGenerate := errors.New("llm down")
+
+	tests := []struct {
+		name    string
+		opts    *FollowUpOptions
+		policy  PanicPolicy
+		want    []string
+		wantErr error
+	}{
+		{"heuristic", nil, "", []string{"go generics constraints"}, nil},
+		{"generator", &FollowUpOptions{Max: 2, Generator: FollowUpGeneratorFunc(func(_ context.Context, r *SearchResponse, max int) ([]string, error) {
+			return []string{" go  generics ", "When to use type parameters", "", "when to use type parameters", "Generic methods", "Constraint inference"}, nil
+		})}, "", []string{"When to use type parameters", "Generic methods"}, nil},
+		{"generator error", &FollowUpOptions{Generator: FollowUpGeneratorFunc(func(context.Context, *SearchResponse, int) ([]string, error) {
+			return nil, errGenerate
+		})}, "", nil, errGenerate},
+		{"generator panic falls back", &FollowUpOptions{Generator: FollowUpGeneratorFunc(func(context.Context, *SearchResponse, int) ([]string, error) {
+			panic("generator exploded")
+		})}, PanicContinue, []string{"go generics constraints"}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("tvly-test-key", &Options{PanicPolicy: tt.policy})
+			got, err := client.SuggestFollowUps(context.Background(), resp, tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("SuggestFollowUps() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("SuggestFollowUps() error = %v", err)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SuggestFollowUps() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	client := New("tvly-test-key", nil)
+	_, err := client.SuggestFollowUps(context.Background(), resp, &FollowUpOptions{Generator: FollowUpGeneratorFunc(func(context.Context, *SearchResponse, int) ([]string, error) {
+		panic("generator exploded")
+	})})
+	var perr *PanicError
+	if !errors.As(err, &perr) || perr.Hook != "FollowUpGenerator" {
+		t.Errorf("SuggestFollowUps() error = %v, want a FollowUpGenerator *PanicError", err)
+	}
+}
@@ -0,0 +1,131 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+)
+
+// ChatMessage is one turn of a conversation passed to GroundMessage.
+type ChatMessage struct {
+	// Role is "user" or "assistant"; other roles, such as "system", are ignored.
+	Role    string
+	Content string
+}
+
+// QueryDeriver derives a search query from a conversation, e.g. by asking an
+// LLM to rewrite the latest message as a standalone question.
+// Implementations must be safe for concurrent use.
+type QueryDeriver interface {
+	DeriveQuery(ctx context.Context, history []ChatMessage, latest string) (string, error)
+}
+
+// QueryDeriverFunc adapts a function to QueryDeriver.
+type QueryDeriverFunc func(ctx context.Context, history []ChatMessage, latest string) (string, error)
+
+// DeriveQuery implements QueryDeriver.
+func (f QueryDeriverFunc) DeriveQuery(ctx context.Context, history []ChatMessage, latest string) (string, error) {
+	return f(ctx, history, latest)
+}
+
+// GroundOptions configures GroundMessage.
+type GroundOptions struct {
+	// Turns is how many of the most recent history messages are considered. Defaults to 4.
+	Turns int
+	// MaxTerms caps the terms of a heuristically derived query. Defaults to 8.
+	MaxTerms int
+	// Deriver derives the query instead of the keyword heuristic.
+	Deriver QueryDeriver
+	// Context configures the returned context.
+	Context *ContextOptions
+}
+
+// Grounding is the search context for a chat message.
+type Grounding struct {
+	// Query is the search query derived from the conversation.
+	Query string
+	// Context is the search results rendered by SearchContext.
+	Context io.Reader
+}
+
+// GroundMessage derives a search query from latest and the recent turns of
+// history, searches for it and returns the results as prompt context. Without
+// a Deriver the query is made of the key terms of latest, topped up with terms
+// from earlier turns so follow-ups like "and its price?" keep their subject.
+// Under PanicContinue a panicking Deriver falls back to the heuristic.
+func (c *Client) GroundMessage(ctx context.Context, history []ChatMessage, latest string, opts *GroundOptions) (*Grounding, error) {
+	if opts == nil {
+		opts = &GroundOptions{}
+	}
+	recent := history[max(len(history)-defaultInt(opts.Turns, 4), 0):]
+
+	query, err := c.deriveQuery(ctx, opts, recent, latest)
+	if err != nil {
+		return nil, err
+	}
+	if query == "" {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "could not derive a search query from the conversation",
+		}
+	}
+
+	r, err := c.SearchContext(ctx, query, opts.Context)
+	if err != nil {
+		return nil, err
+	}
+	return &Grounding{Query: query, Context: r}, nil
+}
+
+func (c *Client) deriveQuery(ctx context.Context, opts *GroundOptions, history []ChatMessage, latest string) (string, error) {
+	if opts.Deriver != nil {
+		var query string
+		var err error
+		perr := c.runHook(ctx, "QueryDeriver", func() { query, err = opts.Deriver.DeriveQuery(ctx, history, latest) })
+		switch {
+		case perr != nil && c.abortOnPanic():
+			return "", perr
+		case perr == nil && err != nil:
+			return "", fmt.Errorf("failed to derive query: %w", err)
+		case perr == nil:
+			return strings.TrimSpace(query), nil
+		}
+	}
+	return DeriveQuery(history, latest, defaultInt(opts.MaxTerms, 8)), nil
+}
+
+// chatFillers are words common in chat messages that make poor search terms.
+var chatFillers = map[string]bool{
+	"what": true, "how": true, "why": true, "who": true, "when": true, "where": true,
+	"does": true, "did": true, "you": true, "your": true, "please": true, "tell": true,
+	"thanks": true, "know": true, "more": true, "any": true,
+}
+
+// DeriveQuery is GroundMessage's keyword heuristic: the key terms of latest,
+// then those of the earlier user and assistant messages, newest first, up to
+// maxTerms terms.
+func DeriveQuery(history []ChatMessage, latest string, maxTerms int) string {
+	texts := []string{latest}
+	for _, role := range []string{"user", "assistant"} {
+		for _, m := range slices.Backward(history) {
+			if strings.EqualFold(m.Role, role) {
+				texts = append(texts, m.Content)
+			}
+		}
+	}
+
+	var terms []string
+	for _, text := range texts {
+		for _, term := range keyTerms(text) {
+			if len(terms) == maxTerms {
+				return strings.Join(terms, " ")
+			}
+			if !chatFillers[term] && !slices.Contains(terms, term) {
+				terms = append(terms, term)
+			}
+		}
+	}
+	return strings.Join(terms, " ")
+}
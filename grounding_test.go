@@ -0,0 +1,110 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestDeriveQuery(t *testing.T) {
+	history := []ChatMessage{
+		{Role: "system", Content: "You are a helpful shopping assistant."},
+		{Role: "user", Content: "Tell me about the Framework Laptop 16"},
+		{Role: "assistant", Content: "It is a modular notebook."},
+	}
+
+	tests := []struct {
+		name     string
+		history  []ChatMessage
+		latest   string
+		maxTerms int
+		want     string
+	}{
+		{"follow-up keeps subject", history, "And what is its price?", 8, "price framework laptop modular notebook"},
+		{"capped", history, "And what is its price?", 3, "price framework laptop"},
+		{"no history", nil, "How do I install Kubernetes on Ubuntu 24.04?", 8, "install kubernetes ubuntu"},
+		{"nothing meaningful", nil, "and the?", 8, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DeriveQuery(tt.history, tt.latest, tt.maxTerms); got != tt.want {
+				t.Errorf("DeriveQuery() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGroundMessage(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.Query
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": [
+			{"url": "https://frame.work/", "title": "Framework", "content": "Laptop 16 pricing.", "score": 0.9}
+		]}`))
+	}))
+	defer server.Close()
+
+	history := []ChatMessage{{Role: "user", Content: "Framework Laptop 16"}}
+	errDerive := errors.New("llm down")
+
+	tests := []struct {
+		name      string
+		opts      *GroundOptions
+		policy    PanicPolicy
+		wantQuery string
+		wantErr   error
+	}{
+		{"heuristic", nil, "", "price framework laptop", nil},
+		{"deriver", &GroundOptions{Deriver: QueryDeriverFunc(func(_ context.Context, h []ChatMessage, latest string) (string, error) {
+			return " Framework Laptop 16 price ", nil
+		})}, "", "Framework Laptop 16 price", nil},
+		{"deriver error", &GroundOptions{Deriver: QueryDeriverFunc(func(context.Context, []ChatMessage, string) (string, error) {
+			return "", errDerive
+		})}, "", "", errDerive},
+		{"deriver panic falls back", &GroundOptions{Deriver: QueryDeriverFunc(func(context.Context, []ChatMessage, string) (string, error) {
+			panic("deriver exploded")
+		})}, PanicContinue, "price framework laptop", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent = ""
+			client := New("tvly-test-key", &Options{BaseURL: server.URL, PanicPolicy: tt.policy})
+			g, err := client.GroundMessage(context.Background(), history, "and its price?", tt.opts)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("GroundMessage() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GroundMessage() error = %v", err)
+			}
+			if g.Query != tt.wantQuery || sent != tt.wantQuery {
+				t.Errorf("GroundMessage() query = %q (sent %q), want %q", g.Query, sent, tt.wantQuery)
+			}
+			text, _ := io.ReadAll(g.Context)
+			if !strings.Contains(string(text), "Laptop 16 pricing.") {
+				t.Errorf("GroundMessage() context = %q, want the result content", text)
+			}
+		})
+	}
+}
+
+func TestGroundMessageEmptyQuery(t *testing.T) {
+	client := New("tvly-test-key", &Options{BaseURL: "http://127.0.0.1:1"})
+	_, err := client.GroundMessage(context.Background(), nil, "and the?", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("GroundMessage() error = %v, want a bad request", err)
+	}
+}
@@ -0,0 +1,105 @@
+package tavily
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// UnknownDateKey is the ResultGroup key for results without a parseable published date.
+const UnknownDateKey = "unknown"
+
+// ResultGroup is an ordered facet of search results sharing a key.
+type ResultGroup struct {
+	Key string
+	// Total is the number of results in the group before truncation to the top results.
+	Total int
+	// Results are the group's top results ordered by descending score.
+	Results []SearchResult
+}
+
+// GroupByDomain groups results by host, ordering groups by their best score.
+// Each group keeps at most topN results; topN <= 0 keeps all of them.
+func GroupByDomain(results []SearchResult, topN int) []ResultGroup {
+	groups := groupResults(results, topN, func(r SearchResult) string {
+		return domainOf(r.URL)
+	})
+
+	slices.SortStableFunc(groups, func(a, b ResultGroup) int {
+		return cmp.Compare(b.Results[0].Score, a.Results[0].Score)
+	})
+	return groups
+}
+
+// GroupByDate groups results by publication day (YYYY-MM-DD), newest first.
+// Results without a parseable PublishedDate are grouped under UnknownDateKey, last.
+// Each group keeps at most topN results; topN <= 0 keeps all of them.
+func GroupByDate(results []SearchResult, topN int) []ResultGroup {
+	groups := groupResults(results, topN, func(r SearchResult) string {
+		t, ok := ParsePublishedDate(r.PublishedDate)
+		if !ok {
+			return UnknownDateKey
+		}
+		return t.UTC().Format(time.DateOnly)
+	})
+
+	slices.SortStableFunc(groups, func(a, b ResultGroup) int {
+		switch {
+		case a.Key == UnknownDateKey:
+			return 1
+		case b.Key == UnknownDateKey:
+			return -1
+		}
+		return cmp.Compare(b.Key, a.Key)
+	})
+	return groups
+}
+
+func groupResults(results []SearchResult, topN int, key func(SearchResult) string) []ResultGroup {
+	index := make(map[string]int)
+	var groups []ResultGroup
+	for _, r := range results {
+		k := key(r)
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, ResultGroup{Key: k})
+		}
+		groups[i].Results = append(groups[i].Results, r)
+	}
+
+	for i := range groups {
+		g := &groups[i]
+		g.Total = len(g.Results)
+		slices.SortStableFunc(g.Results, func(a, b SearchResult) int {
+			return cmp.Compare(b.Score, a.Score)
+		})
+		if topN > 0 && len(g.Results) > topN {
+			g.Results = g.Results[:topN]
+		}
+	}
+	return groups
+}
+
+var publishedDateLayouts = []string{
+	time.RFC3339,
+	time.RFC1123,
+	time.RFC1123Z,
+	time.DateTime,
+	time.DateOnly,
+	"2006-01-02T15:04:05",
+}
+
+// ParsePublishedDate parses the PublishedDate formats returned by the API.
+func ParsePublishedDate(value string) (time.Time, bool) {
+	if value == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range publishedDateLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
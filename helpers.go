@@ -3,6 +3,10 @@ package tavily
 import (
 	"context"
 	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
 )
 
 // SearchSimple performs a basic search with minimal configuration.
@@ -32,6 +36,42 @@ func (c *Client) SearchNews(ctx context.Context, query string, days int) (*Searc
 	return c.Search(ctx, query, opts)
 }
 
+// SearchSince searches for results published at or after since. The window is
+// sent as the narrowest time_range covering it (or days, when context options
+// select the news topic), which can reach further back than since, so results
+// with an earlier PublishedDate are dropped afterwards. A since more than a
+// year ago, which no time_range covers, is only applied by that filter.
+// Results without a parseable date are kept; dates without a time of day
+// match the whole day.
+func (c *Client) SearchSince(ctx context.Context, query string, since time.Time) (*SearchResponse, error) {
+	opts := &SearchOptions{Since: since}
+	if c.clock.Now().Sub(since) > year {
+		opts = nil
+	}
+	resp, err := c.Search(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	resp.Results = slices.DeleteFunc(resp.Results, func(r SearchResult) bool {
+		return !publishedSince(r.PublishedDate, since)
+	})
+	return resp, nil
+}
+
+// publishedSince reports whether a PublishedDate is at or after since, or
+// unknown.
+func publishedSince(published string, since time.Time) bool {
+	t, ok := ParsePublishedDate(published)
+	if !ok {
+		return true
+	}
+	if len(published) == len(time.DateOnly) {
+		y, m, d := since.In(t.Location()).Date()
+		since = time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	}
+	return !t.Before(since)
+}
+
 // ExtractSimple extracts content from a single URL with default settings.
 func (c *Client) ExtractSimple(ctx context.Context, url string) (*ExtractResponse, error) {
 	return c.Extract(ctx, []string{url}, nil)
@@ -42,22 +82,35 @@ func (c *Client) ExtractWithImages(ctx context.Context, urls []string) (*Extract
 	opts := &ExtractOptions{
 		IncludeImages: BoolPtr(true),
 		Format:        string(FormatMarkdown),
-		ExtractDepth:  string(SearchDepthAdvanced),
+		ExtractDepth:  string(ExtractDepthAdvanced),
 	}
 	return c.Extract(ctx, urls, opts)
 }
 
-// CrawlDocumentation crawls a website focusing on documentation pages.
+// CrawlDocumentation crawls a website focusing on documentation pages, starting
+// from DocumentationCrawl. On a docs subdomain such as docs.example.com every
+// path is crawled. Crawl defaults set with WithContextOptions override the
+// preset, e.g. to select a site's own paths.
 func (c *Client) CrawlDocumentation(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
-	opts := &CrawlOptions{
-		MaxDepth:      3,
-		Limit:         maxPages,
-		Categories:    []CrawlCategory{CategoryDocumentation, CategoryDeveloper},
-		SelectPaths:   []string{"/docs/*", "/api/*", "/guide/*", "/tutorial/*"},
-		Format:        string(FormatMarkdown),
-		AllowExternal: BoolPtr(false),
-	}
-	return c.Crawl(ctx, url, opts)
+	return c.crawlPreset(ctx, url, DocumentationCrawl(), documentationSubdomains, maxPages)
+}
+
+// CrawlBlog crawls a website's blog posts, starting from BlogCrawl. It is
+// customized like CrawlDocumentation.
+func (c *Client) CrawlBlog(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return c.crawlPreset(ctx, url, BlogCrawl(), blogSubdomains, maxPages)
+}
+
+// CrawlChangelog crawls a website's changelog and release notes, starting
+// from ChangelogCrawl. It is customized like CrawlDocumentation.
+func (c *Client) CrawlChangelog(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return c.crawlPreset(ctx, url, ChangelogCrawl(), changelogSubdomains, maxPages)
+}
+
+// CrawlPricing crawls a website's pricing and plan pages, starting from
+// PricingCrawl. It is customized like CrawlDocumentation.
+func (c *Client) CrawlPricing(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return c.crawlPreset(ctx, url, PricingCrawl(), nil, maxPages)
 }
 
 // MapSite provides a quick way to map a website structure.
@@ -72,25 +125,23 @@ func (c *Client) MapSite(ctx context.Context, url string) (*MapResponse, error)
 // GetSearchContext returns search results formatted as context for AI applications.
 // This is useful for RAG (Retrieval-Augmented Generation) workflows.
 func (c *Client) GetSearchContext(ctx context.Context, query string, maxTokens int) (string, error) {
-	opts := &SearchOptions{
-		SearchDepth:       string(SearchDepthAdvanced),
-		MaxResults:        5,
-		IncludeRawContent: string(FormatText),
-		MaxTokens:         maxTokens,
-	}
-
-	result, err := c.Search(ctx, query, opts)
+	r, err := c.SearchContext(ctx, query, &ContextOptions{MaxTokens: maxTokens})
 	if err != nil {
-		return "", fmt.Errorf("search failed: %w", err)
+		return "", err
 	}
 
-	context := fmt.Sprintf("Search query: %s\n\n", query)
-	for i, r := range result.Results {
-		context += fmt.Sprintf("Source %d: %s\nURL: %s\nContent: %s\n\n",
-			i+1, r.Title, r.URL, r.Content)
+	var b strings.Builder
+	if _, err := io.Copy(&b, r); err != nil {
+		return "", fmt.Errorf("failed to format context: %w", err)
 	}
+	return b.String(), nil
+}
 
-	return context, nil
+// GetSearchContextReader is GetSearchContext as a stream: each source is
+// formatted only when read, so large contexts can be piped into prompt
+// builders or files without assembling one big string.
+func (c *Client) GetSearchContextReader(ctx context.Context, query string, maxTokens int) (io.Reader, error) {
+	return c.SearchContext(ctx, query, &ContextOptions{MaxTokens: maxTokens})
 }
 
 // BoolPtr is a helper function to get a pointer to a boolean value.
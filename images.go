@@ -0,0 +1,170 @@
+package tavily
+
+import (
+	"net/url"
+	"strings"
+)
+
+// SearchImage is a query-related image returned with IncludeImageDescriptions.
+type SearchImage struct {
+	URL         string `json:"url"`
+	Description string `json:"description"`
+}
+
+// HasDescription reports whether the API returned a non-blank description.
+// It often returns an empty one for images it could not describe.
+func (i SearchImage) HasDescription() bool {
+	return strings.TrimSpace(i.Description) != ""
+}
+
+// UndescribedImages lists the images the API returned without a description
+// although descriptions were requested. It is empty when they were not requested.
+func (r *SearchResponse) UndescribedImages() []string {
+	var urls []string
+	for _, img := range r.ImageDetails {
+		if !img.HasDescription() {
+			urls = append(urls, img.URL)
+		}
+	}
+	return urls
+}
+
+// Image is an image URL paired with the result it came from.
+type Image struct {
+	URL string
+	// SourceURL is the URL of the result the image belongs to, or "" when it
+	// could not be attributed.
+	SourceURL string
+}
+
+// ImageFilter selects images by file extension and host. Empty fields match everything.
+type ImageFilter struct {
+	// Extensions such as "png" or ".jpg", matched case-insensitively.
+	Extensions []string
+	// Hosts keeps only images served from these hosts or their subdomains.
+	Hosts []string
+	// ExcludeHosts drops images served from these hosts or their subdomains.
+	ExcludeHosts []string
+}
+
+// PairedImages attributes the response's flat image list to results. Search
+// returns images separately from results, so each image is paired with the
+// first result on the same site; images that match no result keep an empty
+// SourceURL.
+func (r *SearchResponse) PairedImages() []Image {
+	images := make([]Image, 0, len(r.Images))
+	for _, img := range r.Images {
+		site := siteOf(img)
+		var source string
+		for _, res := range r.Results {
+			if siteOf(res.URL) == site {
+				source = res.URL
+				break
+			}
+		}
+		images = append(images, Image{URL: img, SourceURL: source})
+	}
+	return images
+}
+
+// PairedImages lists the images of every extracted page with the page they were found on.
+func (r *ExtractResponse) PairedImages() []Image {
+	var images []Image
+	for _, res := range r.Results {
+		for _, img := range res.Images {
+			images = append(images, Image{URL: img, SourceURL: res.URL})
+		}
+	}
+	return images
+}
+
+// PairedImages lists the images of every crawled page with the page they were found on.
+func (r *CrawlResponse) PairedImages() []Image {
+	var images []Image
+	for _, res := range r.Results {
+		for _, img := range res.Images {
+			images = append(images, Image{URL: img, SourceURL: res.URL})
+		}
+	}
+	return images
+}
+
+// DedupeImages drops images whose URL repeats an earlier one, ignoring scheme,
+// host case and fragments. The first occurrence, and its SourceURL, is kept.
+func DedupeImages(images []Image) []Image {
+	seen := make(map[string]bool, len(images))
+	var unique []Image
+	for _, img := range images {
+		key := imageKey(img.URL)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		unique = append(unique, img)
+	}
+	return unique
+}
+
+// FilterImages returns the images matching f, in order.
+func FilterImages(images []Image, f ImageFilter) []Image {
+	var kept []Image
+	for _, img := range images {
+		if f.matches(img.URL) {
+			kept = append(kept, img)
+		}
+	}
+	return kept
+}
+
+func (f ImageFilter) matches(rawURL string) bool {
+	if len(f.Extensions) > 0 {
+		ext := strings.TrimPrefix(urlExtension(rawURL), ".")
+		found := false
+		for _, want := range f.Extensions {
+			if strings.EqualFold(strings.TrimPrefix(want, "."), ext) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	host := domainOf(rawURL)
+	if len(f.Hosts) > 0 && !hostInList(host, f.Hosts) {
+		return false
+	}
+	return !hostInList(host, f.ExcludeHosts)
+}
+
+func hostInList(host string, hosts []string) bool {
+	for _, h := range hosts {
+		h = strings.ToLower(h)
+		if host == h || strings.HasSuffix(host, "."+h) {
+			return true
+		}
+	}
+	return false
+}
+
+func imageKey(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	u.Scheme = ""
+	u.Fragment = ""
+	u.Host = strings.ToLower(u.Host)
+	return u.String()
+}
+
+// siteOf returns the last two labels of rawURL's host, so images on a CDN
+// subdomain (cdn.example.com) pair with pages on www.example.com.
+func siteOf(rawURL string) string {
+	labels := strings.Split(domainOf(rawURL), ".")
+	if len(labels) > 2 {
+		labels = labels[len(labels)-2:]
+	}
+	return strings.Join(labels, ".")
+}
@@ -0,0 +1,134 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestSearchPairedImages(t *testing.T) {
+	resp := &SearchResponse{
+		Images: []string{
+			"https://cdn.example.com/a.png",
+			"https://other.org/b.jpg",
+		},
+		Results: []SearchResult{
+			{URL: "https://news.site/story"},
+			{URL: "https://www.example.com/post"},
+		},
+	}
+
+	want := []Image{
+		{URL: "https://cdn.example.com/a.png", SourceURL: "https://www.example.com/post"},
+		{URL: "https://other.org/b.jpg"},
+	}
+	if got := resp.PairedImages(); !slices.Equal(got, want) {
+		t.Errorf("PairedImages() = %v, want %v", got, want)
+	}
+}
+
+func TestCrawlPairedImages(t *testing.T) {
+	resp := &CrawlResponse{Results: []CrawlResult{
+		{URL: "https://example.com/a", Images: []string{"https://example.com/1.png"}},
+		{URL: "https://example.com/b"},
+		{URL: "https://example.com/c", Images: []string{"https://example.com/2.png", "https://example.com/1.png"}},
+	}}
+
+	want := []Image{
+		{URL: "https://example.com/1.png", SourceURL: "https://example.com/a"},
+		{URL: "https://example.com/2.png", SourceURL: "https://example.com/c"},
+		{URL: "https://example.com/1.png", SourceURL: "https://example.com/c"},
+	}
+	if got := resp.PairedImages(); !slices.Equal(got, want) {
+		t.Errorf("PairedImages() = %v, want %v", got, want)
+	}
+}
+
+func TestDedupeAndFilterImages(t *testing.T) {
+	images := []Image{
+		{URL: "https://Example.com/a.PNG", SourceURL: "first"},
+		{URL: "http://example.com/a.PNG#zoom", SourceURL: "second"},
+		{URL: "https://cdn.tracker.net/pixel.gif"},
+		{URL: "https://img.example.com/b.jpg"},
+		{URL: "https://example.com/c.svg"},
+	}
+
+	unique := DedupeImages(images)
+	if len(unique) != 4 || unique[0].SourceURL != "first" {
+		t.Errorf("DedupeImages() = %v, want 4 images keeping the first copy", unique)
+	}
+
+	tests := []struct {
+		name   string
+		filter ImageFilter
+		want   int
+	}{
+		{"no filter", ImageFilter{}, 4},
+		{"extensions", ImageFilter{Extensions: []string{"png", ".JPG"}}, 2},
+		{"hosts", ImageFilter{Hosts: []string{"example.com"}}, 3},
+		{"exclude hosts", ImageFilter{ExcludeHosts: []string{"tracker.net"}}, 3},
+		{"combined", ImageFilter{Extensions: []string{"jpg"}, ExcludeHosts: []string{"img.example.com"}}, 0},
+	}
+	for _, tt := range tests {
+		if got := FilterImages(unique, tt.filter); len(got) != tt.want {
+			t.Errorf("FilterImages(%s) = %v, want %d images", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSearchImageDescriptions(t *testing.T) {
+	var got SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&got)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": [], "images": [
+			{"url": "https://example.com/a.png", "description": "A chart"},
+			{"url": "https://example.com/b.png", "description": " "}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	resp, err := client.Search(context.Background(), "q", &SearchOptions{IncludeImageDescriptions: BoolPtr(true)})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if got.IncludeImages == nil || !*got.IncludeImages {
+		t.Error("Expected IncludeImageDescriptions to turn on include_images")
+	}
+	if want := []string{"https://example.com/a.png", "https://example.com/b.png"}; !slices.Equal(resp.Images, want) {
+		t.Errorf("Images = %v, want %v", resp.Images, want)
+	}
+	if len(resp.ImageDetails) != 2 || resp.ImageDetails[0].Description != "A chart" {
+		t.Errorf("ImageDetails = %v", resp.ImageDetails)
+	}
+	if want := []string{"https://example.com/b.png"}; !slices.Equal(resp.UndescribedImages(), want) {
+		t.Errorf("UndescribedImages() = %v, want %v", resp.UndescribedImages(), want)
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		t.Fatalf("Marshal() error = %v", err)
+	}
+	var decoded SearchResponse
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if !slices.Equal(decoded.ImageDetails, resp.ImageDetails) || !slices.Equal(decoded.Images, resp.Images) {
+		t.Errorf("round trip = %v, %v, want %v, %v", decoded.Images, decoded.ImageDetails, resp.Images, resp.ImageDetails)
+	}
+}
+
+func TestSearchPlainImages(t *testing.T) {
+	var resp SearchResponse
+	if err := json.Unmarshal([]byte(`{"query": "q", "images": ["https://example.com/a.png"], "results": []}`), &resp); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+	if len(resp.Images) != 1 || resp.ImageDetails != nil || resp.UndescribedImages() != nil {
+		t.Errorf("Images, ImageDetails = %v, %v, want one URL and no details", resp.Images, resp.ImageDetails)
+	}
+}
@@ -0,0 +1,130 @@
+package tavily
+
+import (
+	"strings"
+	"time"
+)
+
+// InstructionsBuilder composes natural-language crawl instructions from structured intents.
+// The result is suitable for CrawlOptions.Instructions and MapOptions.Instructions.
+//
+//	instructions := tavily.NewInstructions().
+//		About("pricing changes").
+//		UpdatedAfter(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)).
+//		Build()
+type InstructionsBuilder struct {
+	topics     []string
+	excluded   []string
+	preferred  []string
+	categories []CrawlCategory
+	after      time.Time
+	before     time.Time
+	language   string
+	extra      []string
+}
+
+// NewInstructions creates an empty InstructionsBuilder.
+func NewInstructions() *InstructionsBuilder {
+	return &InstructionsBuilder{}
+}
+
+// About restricts the crawl to pages about the given topics.
+func (b *InstructionsBuilder) About(topics ...string) *InstructionsBuilder {
+	b.topics = append(b.topics, topics...)
+	return b
+}
+
+// Excluding asks the crawler to skip pages about the given topics.
+func (b *InstructionsBuilder) Excluding(topics ...string) *InstructionsBuilder {
+	b.excluded = append(b.excluded, topics...)
+	return b
+}
+
+// Preferring asks the crawler to prioritize the given kinds of pages.
+func (b *InstructionsBuilder) Preferring(kinds ...string) *InstructionsBuilder {
+	b.preferred = append(b.preferred, kinds...)
+	return b
+}
+
+// InCategories focuses the crawl on pages of the given categories.
+func (b *InstructionsBuilder) InCategories(categories ...CrawlCategory) *InstructionsBuilder {
+	b.categories = append(b.categories, categories...)
+	return b
+}
+
+// UpdatedAfter restricts the crawl to pages updated after t.
+func (b *InstructionsBuilder) UpdatedAfter(t time.Time) *InstructionsBuilder {
+	b.after = t
+	return b
+}
+
+// UpdatedBefore restricts the crawl to pages updated before t.
+func (b *InstructionsBuilder) UpdatedBefore(t time.Time) *InstructionsBuilder {
+	b.before = t
+	return b
+}
+
+// InLanguage restricts the crawl to pages written in the given language.
+func (b *InstructionsBuilder) InLanguage(language string) *InstructionsBuilder {
+	b.language = language
+	return b
+}
+
+// With appends a free-form sentence to the instructions.
+func (b *InstructionsBuilder) With(sentence string) *InstructionsBuilder {
+	b.extra = append(b.extra, sentence)
+	return b
+}
+
+// Build renders the collected intents as instruction sentences.
+func (b *InstructionsBuilder) Build() string {
+	var sentences []string
+
+	if len(b.topics) > 0 {
+		sentences = append(sentences, "Only include pages about "+joinList(b.topics)+".")
+	}
+	if len(b.categories) > 0 {
+		names := make([]string, len(b.categories))
+		for i, c := range b.categories {
+			names[i] = string(c)
+		}
+		sentences = append(sentences, "Focus on "+joinList(names)+" pages.")
+	}
+	switch {
+	case !b.after.IsZero() && !b.before.IsZero():
+		sentences = append(sentences, "Only include pages updated between "+
+			b.after.Format(time.DateOnly)+" and "+b.before.Format(time.DateOnly)+".")
+	case !b.after.IsZero():
+		sentences = append(sentences, "Only include pages updated after "+b.after.Format(time.DateOnly)+".")
+	case !b.before.IsZero():
+		sentences = append(sentences, "Only include pages updated before "+b.before.Format(time.DateOnly)+".")
+	}
+	if b.language != "" {
+		sentences = append(sentences, "Only include pages written in "+b.language+".")
+	}
+	if len(b.preferred) > 0 {
+		sentences = append(sentences, "Prefer "+joinList(b.preferred)+".")
+	}
+	if len(b.excluded) > 0 {
+		sentences = append(sentences, "Skip pages about "+joinList(b.excluded)+".")
+	}
+	for _, s := range b.extra {
+		if s = strings.TrimSpace(s); s != "" {
+			sentences = append(sentences, s)
+		}
+	}
+
+	return strings.Join(sentences, " ")
+}
+
+func joinList(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " or " + items[1]
+	}
+	return strings.Join(items[:len(items)-1], ", ") + " or " + items[len(items)-1]
+}
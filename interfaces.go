@@ -0,0 +1,31 @@
+package tavily
+
+import "context"
+
+// Searcher is the search capability of Client. Libraries built on go-tavily
+// can depend on the single capability they use and mock it trivially.
+type Searcher interface {
+	Search(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error)
+}
+
+// Extractor is the extract capability of Client.
+type Extractor interface {
+	Extract(ctx context.Context, urls []string, opts *ExtractOptions) (*ExtractResponse, error)
+}
+
+// Crawler is the crawl capability of Client.
+type Crawler interface {
+	Crawl(ctx context.Context, url string, opts *CrawlOptions) (*CrawlResponse, error)
+}
+
+// Mapper is the map capability of Client.
+type Mapper interface {
+	Map(ctx context.Context, url string, opts *MapOptions) (*MapResponse, error)
+}
+
+var (
+	_ Searcher  = (*Client)(nil)
+	_ Extractor = (*Client)(nil)
+	_ Crawler   = (*Client)(nil)
+	_ Mapper    = (*Client)(nil)
+)
@@ -0,0 +1,107 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+)
+
+// Translator translates search queries for SearchInLanguages, e.g. backed by a
+// translation API or an LLM. Implementations must be safe for concurrent use.
+type Translator interface {
+	// Translate returns text translated into language, as named by the caller
+	// of SearchInLanguages (typically a code such as "de" or "pt-BR").
+	Translate(ctx context.Context, text, language string) (string, error)
+}
+
+// TranslatorFunc adapts a function to Translator.
+type TranslatorFunc func(ctx context.Context, text, language string) (string, error)
+
+// Translate implements Translator.
+func (f TranslatorFunc) Translate(ctx context.Context, text, language string) (string, error) {
+	return f(ctx, text, language)
+}
+
+// errSkipLanguage marks a language left out after its translator panicked
+// under PanicContinue.
+var errSkipLanguage = errors.New("translation skipped")
+
+// SearchInLanguages translates query into each language and runs the
+// translations in parallel, merging the results into one response ordered by
+// score, like SearchAcrossTopics. Each result's Language is set to the
+// language that returned it, and its Provenance records the translated query.
+// Include the query's own language to search the original too; the
+// translator is still asked, and may return the text unchanged.
+//
+// Under PanicContinue a language whose translation panics is left out.
+func (c *Client) SearchInLanguages(ctx context.Context, query string, opts *SearchOptions, translator Translator, languages ...string) (*SearchResponse, error) {
+	if translator == nil {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "a Translator is required",
+		}
+	}
+	if len(languages) == 0 {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "at least one language is required",
+		}
+	}
+
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	responses := make([]*SearchResponse, len(languages))
+	errs := make([]error, len(languages))
+
+	var wg sync.WaitGroup
+	for i, language := range languages {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			translated, err := c.translateQuery(ctx, translator, query, language)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			responses[i], errs[i] = c.Search(ctx, translated, opts.Clone())
+		}()
+	}
+	wg.Wait()
+
+	var kept []*SearchResponse
+	var keptLanguages []string
+	for i, err := range errs {
+		if errors.Is(err, errSkipLanguage) {
+			continue
+		}
+		if err != nil {
+			return nil, fmt.Errorf("language %s: %w", languages[i], err)
+		}
+		kept = append(kept, responses[i])
+		keptLanguages = append(keptLanguages, languages[i])
+	}
+
+	return mergeTaggedResponses(query, kept, func(i int, r *SearchResult) { r.Language = keptLanguages[i] }), nil
+}
+
+// translateQuery runs translator under the client's PanicPolicy.
+func (c *Client) translateQuery(ctx context.Context, translator Translator, query, language string) (string, error) {
+	var translated string
+	var err error
+	if perr := c.runHook(ctx, "Translator", func() { translated, err = translator.Translate(ctx, query, language) }); perr != nil {
+		if c.abortOnPanic() {
+			return "", perr
+		}
+		return "", errSkipLanguage
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to translate query: %w", err)
+	}
+	if translated == "" {
+		return "", fmt.Errorf("failed to translate query: empty translation")
+	}
+	return translated, nil
+}
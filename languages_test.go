@@ -0,0 +1,104 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSearchInLanguages(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		switch req.Query {
+		case "[de] chip shortage":
+			w.Write([]byte(`{"query": "q", "results": [
+				{"url": "https://heise.de/a", "score": 0.8},
+				{"url": "https://shared.example/", "score": 0.4}
+			]}`))
+		case "[fr] chip shortage":
+			w.Write([]byte(`{"query": "q", "results": [
+				{"url": "https://lemonde.fr/b", "score": 0.9},
+				{"url": "https://shared.example/", "score": 0.6}
+			]}`))
+		default:
+			t.Errorf("Search() query = %q", req.Query)
+			w.Write([]byte(`{"query": "q", "results": []}`))
+		}
+	}))
+	defer server.Close()
+
+	translator := TranslatorFunc(func(_ context.Context, text, language string) (string, error) {
+		if language == "ja" {
+			panic("no japanese")
+		}
+		return "[" + language + "] " + text, nil
+	})
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, PanicPolicy: PanicContinue})
+	result, err := client.SearchInLanguages(context.Background(), "chip shortage", nil, translator, "de", "fr", "ja")
+	if err != nil {
+		t.Fatalf("SearchInLanguages() error = %v", err)
+	}
+
+	want := []struct {
+		url, language, query string
+	}{
+		{"https://lemonde.fr/b", "fr", "[fr] chip shortage"},
+		{"https://heise.de/a", "de", "[de] chip shortage"},
+		{"https://shared.example/", "fr", "[fr] chip shortage"},
+	}
+	if len(result.Results) != len(want) {
+		t.Fatalf("SearchInLanguages() results = %v, want %d", resultURLList(result.Results), len(want))
+	}
+	for i, w := range want {
+		r := result.Results[i]
+		if r.URL != w.url || r.Language != w.language || r.Provenance.Query != w.query {
+			t.Errorf("SearchInLanguages() result %d = %v/%v/%v, want %v/%v/%v",
+				i, r.URL, r.Language, r.Provenance.Query, w.url, w.language, w.query)
+		}
+	}
+	if result.Query != "chip shortage" {
+		t.Errorf("SearchInLanguages() query = %v, want %v", result.Query, "chip shortage")
+	}
+}
+
+func TestSearchInLanguagesErrors(t *testing.T) {
+	errTranslate := errors.New("quota exceeded")
+	client := New("tvly-test-key", &Options{BaseURL: "http://127.0.0.1:1"})
+	echo := TranslatorFunc(func(_ context.Context, text, _ string) (string, error) { return text, nil })
+
+	tests := []struct {
+		name       string
+		translator Translator
+		languages  []string
+		wantErr    error
+		wantBad    bool
+	}{
+		{"no translator", nil, []string{"de"}, nil, true},
+		{"no languages", echo, nil, nil, true},
+		{"translation fails", TranslatorFunc(func(context.Context, string, string) (string, error) {
+			return "", errTranslate
+		}), []string{"de"}, errTranslate, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.SearchInLanguages(context.Background(), "q", nil, tt.translator, tt.languages...)
+			if tt.wantBad {
+				var apiErr *APIError
+				if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+					t.Errorf("SearchInLanguages() error = %v, want a bad request", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("SearchInLanguages() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+)
+
+// ErrClientClosed is returned by calls made after Close.
+var ErrClientClosed = errors.New("tavily: client is closed")
+
+// lifecycle tracks in-flight requests so Close can drain them.
+type lifecycle struct {
+	mu       sync.Mutex
+	closed   bool
+	inflight sync.WaitGroup
+
+	// closingCtx is cancelled as soon as Close is called, stopping
+	// long-running loops such as ChangelogMonitor.Run.
+	closingCtx context.Context
+	closing    context.CancelFunc
+
+	// abortCtx is cancelled when Close gives up waiting, aborting in-flight requests.
+	abortCtx context.Context
+	abort    context.CancelFunc
+}
+
+func newLifecycle() *lifecycle {
+	l := &lifecycle{}
+	l.closingCtx, l.closing = context.WithCancel(context.Background())
+	l.abortCtx, l.abort = context.WithCancel(context.Background())
+	return l
+}
+
+// untilClosed returns a context that is also cancelled, with cause
+// ErrClientClosed, when Close is called.
+func (l *lifecycle) untilClosed(ctx context.Context) (context.Context, func()) {
+	ctx, cancel := context.WithCancelCause(ctx)
+	stop := context.AfterFunc(l.closingCtx, func() { cancel(ErrClientClosed) })
+	return ctx, func() {
+		stop()
+		cancel(nil)
+	}
+}
+
+// begin registers an in-flight operation. The returned context is cancelled if
+// Close times out; the returned function must be called when the operation ends.
+func (l *lifecycle) begin(ctx context.Context) (context.Context, func(), error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.closed {
+		return nil, nil, ErrClientClosed
+	}
+	l.inflight.Add(1)
+
+	ctx, cancel := context.WithCancel(ctx)
+	stop := context.AfterFunc(l.abortCtx, cancel)
+	return ctx, func() {
+		stop()
+		cancel()
+		l.inflight.Done()
+	}, nil
+}
+
+// inflightBody keeps the operation that produced a response body in flight
+// until the body is closed.
+type inflightBody struct {
+	io.ReadCloser
+	done func()
+}
+
+func (b *inflightBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.done()
+	return err
+}
+
+// Close stops accepting new calls and waits for in-flight requests, including
+// link checks and other pages fetched directly, to finish. If ctx expires
+// first, remaining requests are aborted and ctx's error is returned. Calling
+// Close more than once is safe.
+func (c *Client) Close(ctx context.Context) error {
+	l := c.lifecycle
+	l.mu.Lock()
+	if l.closed {
+		l.mu.Unlock()
+		return nil
+	}
+	l.closed = true
+	l.mu.Unlock()
+	l.closing()
+
+	drained := make(chan struct{})
+	go func() {
+		l.inflight.Wait()
+		close(drained)
+	}()
+
+	var err error
+	select {
+	case <-drained:
+	case <-ctx.Done():
+		l.abort()
+		<-drained
+		err = ctx.Err()
+	}
+	l.abort()
+	return err
+}
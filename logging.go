@@ -0,0 +1,132 @@
+package tavily
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+const redactedPlaceholder = "[REDACTED]"
+
+var defaultRedactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`tvly-[A-Za-z0-9_\-]+`),
+}
+
+// prefixRedactPatterns mask all but their first capture group, which names
+// the secret rather than being part of it.
+var prefixRedactPatterns = []*regexp.Regexp{
+	regexp.MustCompile(`(?i)(bearer\s+)[^\s"',]+`),
+}
+
+// Redactor masks API keys and other sensitive values in text destined for logs
+// and error messages. The zero value masks nothing; use NewRedactor.
+type Redactor struct {
+	secrets  []string
+	patterns []*regexp.Regexp
+}
+
+// NewRedactor creates a Redactor masking the given literal secrets, Tavily API
+// keys, bearer tokens and any text matching the extra patterns. The whole
+// match of an extra pattern is masked, capture groups included.
+func NewRedactor(secrets []string, patterns ...*regexp.Regexp) *Redactor {
+	r := &Redactor{patterns: append(slices.Clone(defaultRedactPatterns), patterns...)}
+	for _, s := range secrets {
+		if s != "" {
+			r.secrets = append(r.secrets, s)
+		}
+	}
+	return r
+}
+
+// Redact returns s with every sensitive value replaced by a placeholder.
+func (r *Redactor) Redact(s string) string {
+	if r == nil {
+		return s
+	}
+	for _, secret := range r.secrets {
+		s = strings.ReplaceAll(s, secret, redactedPlaceholder)
+	}
+	for _, re := range prefixRedactPatterns {
+		s = re.ReplaceAllString(s, "${1}"+redactedPlaceholder)
+	}
+	for _, re := range r.patterns {
+		s = re.ReplaceAllLiteralString(s, redactedPlaceholder)
+	}
+	return s
+}
+
+// redactingHandler passes every record through a Redactor before the wrapped
+// handler sees it, so no log path can emit credentials.
+type redactingHandler struct {
+	next     slog.Handler
+	redactor *Redactor
+}
+
+func (h *redactingHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	return h.next.Enabled(ctx, level)
+}
+
+func (h *redactingHandler) Handle(ctx context.Context, record slog.Record) error {
+	redacted := slog.NewRecord(record.Time, record.Level, h.redactor.Redact(record.Message), record.PC)
+	record.Attrs(func(a slog.Attr) bool {
+		redacted.AddAttrs(h.redactAttr(a))
+		return true
+	})
+	return h.next.Handle(ctx, redacted)
+}
+
+func (h *redactingHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	redacted := make([]slog.Attr, len(attrs))
+	for i, a := range attrs {
+		redacted[i] = h.redactAttr(a)
+	}
+	return &redactingHandler{next: h.next.WithAttrs(redacted), redactor: h.redactor}
+}
+
+func (h *redactingHandler) WithGroup(name string) slog.Handler {
+	return &redactingHandler{next: h.next.WithGroup(name), redactor: h.redactor}
+}
+
+func (h *redactingHandler) redactAttr(a slog.Attr) slog.Attr {
+	v := a.Value.Resolve()
+	switch v.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, h.redactor.Redact(v.String()))
+	case slog.KindGroup:
+		group := v.Group()
+		redacted := make([]any, len(group))
+		for i, ga := range group {
+			redacted[i] = h.redactAttr(ga)
+		}
+		return slog.Group(a.Key, redacted...)
+	case slog.KindAny:
+		if err, ok := v.Any().(error); ok {
+			return slog.String(a.Key, h.redactor.Redact(err.Error()))
+		}
+		return slog.String(a.Key, h.redactor.Redact(v.String()))
+	default:
+		return slog.Attr{Key: a.Key, Value: v}
+	}
+}
+
+func newLogger(opts *Options, redactor *Redactor) *slog.Logger {
+	logger := opts.Logger
+	if logger == nil {
+		if !opts.Debug {
+			return nil
+		}
+		logger = slog.Default()
+	}
+	return slog.New(&redactingHandler{next: logger.Handler(), redactor: redactor})
+}
+
+func (c *Client) logDebug(ctx context.Context, msg string, args ...any) {
+	if c.logger != nil {
+		for _, a := range callOptionsFrom(ctx).LogAttrs {
+			args = append(args, a)
+		}
+		c.logger.DebugContext(ctx, msg, args...)
+	}
+}
@@ -0,0 +1,78 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestRedactor(t *testing.T) {
+	r := NewRedactor([]string{"my-secret"}, regexp.MustCompile(`ssn:\d+`))
+
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "key tvly-abc123_DEF", want: "key [REDACTED]"},
+		{in: "Authorization: Bearer xyz.123", want: "Authorization: Bearer [REDACTED]"},
+		{in: "token=my-secret", want: "token=[REDACTED]"},
+		{in: "query about ssn:123456", want: "query about [REDACTED]"},
+		{in: "nothing sensitive", want: "nothing sensitive"},
+	}
+
+	for _, tt := range tests {
+		if got := r.Redact(tt.in); got != tt.want {
+			t.Errorf("Redact(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+
+	// A capture group in a user pattern must not keep part of the match.
+	grouped := NewRedactor(nil, regexp.MustCompile(`ssn:(\d+)`))
+	if got, want := grouped.Redact("id ssn:123456"), "id [REDACTED]"; got != want {
+		t.Errorf("Redact() with grouped pattern = %q, want %q", got, want)
+	}
+}
+
+func TestDebugLoggingRedactsCredentials(t *testing.T) {
+	const apiKey = "tvly-supersecret"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(`{"detail": {"error": "Invalid API key: ` + apiKey + `"}}`))
+	}))
+	defer server.Close()
+
+	var logs bytes.Buffer
+	client := New(apiKey, &Options{
+		BaseURL:        server.URL,
+		Logger:         slog.New(slog.NewTextHandler(&logs, &slog.HandlerOptions{Level: slog.LevelDebug})),
+		Debug:          true,
+		RedactPatterns: []*regexp.Regexp{regexp.MustCompile(`patient \w+`)},
+	})
+
+	_, err := client.Search(context.Background(), "records for patient smith", nil)
+
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("Expected *APIError, got %T", err)
+	}
+	if strings.Contains(apiErr.Message, apiKey) {
+		t.Errorf("error message leaks API key: %v", apiErr.Message)
+	}
+
+	output := logs.String()
+	if output == "" {
+		t.Fatal("Expected debug logs, got none")
+	}
+	for _, secret := range []string{apiKey, "patient smith"} {
+		if strings.Contains(output, secret) {
+			t.Errorf("logs leak %q:\n%s", secret, output)
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultMapMemoTTL is how long a MapMemo without a TTL serves a site map.
+const DefaultMapMemoTTL = 15 * time.Minute
+
+// MapMemo remembers Map responses per site, for CI-like workflows and crawl
+// planning that map the same site repeatedly within a session. Unlike Cache
+// it applies to Map only and needs no serialization. Entries are keyed by the
+// site and the full request, so different options map separately; "https://
+// www.example.com/docs/" and "example.com/docs" share an entry. Concurrent
+// calls for the same entry wait for a single Map request. Errors are not
+// remembered. The zero value is ready to use and safe for concurrent use.
+type MapMemo struct {
+	// TTL bounds how long a map is reused. Defaults to DefaultMapMemoTTL.
+	TTL time.Duration
+	// Clock decides when maps expire. Nil uses SystemClock.
+	Clock Clock
+
+	mu      sync.Mutex
+	entries map[string]mapMemoEntry
+	flights flightGroup[*MapResponse]
+}
+
+type mapMemoEntry struct {
+	site    string
+	resp    *MapResponse
+	expires time.Time
+}
+
+// Len returns the number of remembered maps, including expired ones not yet replaced.
+func (m *MapMemo) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.entries)
+}
+
+// Forget drops every remembered map of site, e.g. "example.com" or a URL on
+// it, after the site has changed.
+func (m *MapMemo) Forget(site string) {
+	site = memoSite(site)
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for key, e := range m.entries {
+		if e.site == site {
+			delete(m.entries, key)
+		}
+	}
+}
+
+// do returns the remembered response to req or maps with fn, sharing one fn
+// call between concurrent callers. Remembered and shared responses are
+// returned as copies with Meta.CacheHit set.
+func (m *MapMemo) do(ctx context.Context, req *MapRequest, fn func(context.Context) (*MapResponse, error)) (*MapResponse, error) {
+	site := memoSite(req.URL)
+	keyReq := *req
+	keyReq.URL = normalizeResultURL(req.URL)
+	body, err := json.Marshal(&keyReq)
+	if err != nil {
+		return fn(ctx)
+	}
+	key := string(body)
+
+	lookup := func() (*MapResponse, bool) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if e, ok := m.entries[key]; ok && clockOr(m.Clock).Now().Before(e.expires) {
+			return e.resp.memoCopy(), true
+		}
+		return nil, false
+	}
+	store := func(resp *MapResponse) {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+		if m.entries == nil {
+			m.entries = make(map[string]mapMemoEntry)
+		}
+		ttl := m.TTL
+		if ttl <= 0 {
+			ttl = DefaultMapMemoTTL
+		}
+		m.entries[key] = mapMemoEntry{site: site, resp: resp.memoCopy(), expires: clockOr(m.Clock).Now().Add(ttl)}
+	}
+
+	resp, shared, err := m.flights.do(ctx, key, lookup, fn, store)
+	if err != nil {
+		return nil, err
+	}
+	if shared {
+		return resp.memoCopy(), nil
+	}
+	return resp, nil
+}
+
+func memoSite(rawURL string) string {
+	return strings.TrimPrefix(domainOf(rawURL), "www.")
+}
+
+// memoCopy returns a copy of r marked as served from the memo.
+func (r *MapResponse) memoCopy() *MapResponse {
+	c := *r
+	c.Results = slices.Clone(r.Results)
+	c.Meta.CacheHit = true
+	return &c
+}
@@ -0,0 +1,143 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestMapMemo(t *testing.T) {
+	var calls atomic.Int32
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		<-release
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"base_url": "https://example.com/docs", "results": ["https://example.com/docs/a", "https://example.com/docs/b"]}`))
+	}))
+	defer server.Close()
+
+	clock := tavilytest.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+	memo := &MapMemo{TTL: time.Hour, Clock: clock}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, MapMemo: memo})
+	ctx := context.Background()
+
+	sites := []string{"https://example.com/docs", "https://www.example.com/docs/", "example.com/docs"}
+	var wg sync.WaitGroup
+	for _, site := range sites {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.MapSite(ctx, site)
+			if err != nil || len(resp.Results) != 2 {
+				t.Errorf("MapSite(%q) = %+v, %v", site, resp, err)
+			}
+		}()
+	}
+	for calls.Load() == 0 {
+		runtime.Gosched()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("server calls = %d, want 1", got)
+	}
+
+	resp, err := client.PreviewCrawl(ctx, "https://example.com/docs", &CrawlOptions{MaxDepth: 2, Limit: 100})
+	if err != nil {
+		t.Fatalf("PreviewCrawl() error = %v", err)
+	}
+	if !resp.Meta.CacheHit || calls.Load() != 1 {
+		t.Errorf("PreviewCrawl() with MapSite's options cache hit = %v after %d calls, want a memo hit", resp.Meta.CacheHit, calls.Load())
+	}
+
+	// Returned maps are copies.
+	resp.URLs[0] = "changed"
+	again, _ := client.MapSite(ctx, "https://example.com/docs")
+	if again.Results[0] != "https://example.com/docs/a" {
+		t.Errorf("MapSite() after modifying a result = %v, want the remembered map", again.Results)
+	}
+
+	if _, err := client.Map(ctx, "https://example.com/docs", &MapOptions{Limit: 10}); err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("server calls after different options = %d, want 2", got)
+	}
+	if got := memo.Len(); got != 2 {
+		t.Errorf("Len() = %d, want 2", got)
+	}
+
+	clock.Advance(time.Hour)
+	client.MapSite(ctx, "https://example.com/docs")
+	if got := calls.Load(); got != 3 {
+		t.Errorf("server calls after the TTL = %d, want 3", got)
+	}
+
+	memo.Forget("www.example.com")
+	if got := memo.Len(); got != 0 {
+		t.Errorf("Len() after Forget = %d, want 0", got)
+	}
+}
+
+func TestMapMemoErrors(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"detail": {"error": "bad url"}}`))
+	}))
+	defer server.Close()
+
+	memo := &MapMemo{}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, MapMemo: memo})
+	for range 2 {
+		if _, err := client.MapSite(context.Background(), "https://example.com"); err == nil {
+			t.Fatal("MapSite() error = nil, want error")
+		}
+	}
+	if got := calls.Load(); got != 2 || memo.Len() != 0 {
+		t.Errorf("server calls = %d with %d remembered, want 2 calls and none remembered", got, memo.Len())
+	}
+}
+
+func TestMapMemoCancelledLeader(t *testing.T) {
+	memo := &MapMemo{}
+	req := &MapRequest{URL: "https://example.com"}
+	started, release := make(chan struct{}), make(chan struct{})
+	go memo.do(context.Background(), req, func(context.Context) (*MapResponse, error) {
+		close(started)
+		<-release
+		return nil, context.DeadlineExceeded
+	})
+	<-started
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := memo.do(ctx, req, nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("do() with a cancelled context error = %v, want %v", err, context.Canceled)
+	}
+
+	waiter := make(chan *MapResponse, 1)
+	go func() {
+		resp, _ := memo.do(context.Background(), req, func(context.Context) (*MapResponse, error) {
+			return &MapResponse{BaseURL: "https://example.com"}, nil
+		})
+		waiter <- resp
+	}()
+	time.Sleep(20 * time.Millisecond)
+	close(release)
+	if resp := <-waiter; resp == nil || resp.Meta.CacheHit {
+		t.Errorf("do() after a timed out leader = %+v, want a fresh map", resp)
+	}
+}
@@ -0,0 +1,136 @@
+package tavily
+
+import (
+	"encoding/json"
+	"math"
+	"slices"
+	"time"
+)
+
+// ResponseMeta carries client-side metadata about the call that produced a response.
+type ResponseMeta struct {
+	// RequestID is the ID sent in the X-Request-ID header.
+	RequestID string
+	// RetrievedAt is when the response was received, or served from a cache.
+	RetrievedAt time.Time
+	// ElapsedWallClock is the total time spent on the call, including retries and follow-ups.
+	ElapsedWallClock time.Duration
+	// Attempts is the number of HTTP requests made, including retries and follow-ups.
+	Attempts int
+	// CacheHit reports whether the response was served from a cache.
+	CacheHit bool
+	// EstimatedCredits is a client-side estimate of the API credits consumed,
+	// based on the published pricing for each endpoint.
+	EstimatedCredits float64
+	// SchemaDrift lists fields violating the documented schema. It is only
+	// populated when Options.ValidateResponses is set.
+	SchemaDrift []SchemaDrift
+	// QueryRewrite records the rewrite Options.QueryRewriter applied to a
+	// search query, or is nil if it left the query unchanged.
+	QueryRewrite *QueryRewrite
+	// PIIRedactions counts the spans Options.PIIScrubber replaced.
+	PIIRedactions int
+
+	// raw is the response body, kept when Options.KeepRawResponses is set.
+	raw json.RawMessage
+	// bodies are the kept bodies of every call folded into a combined response.
+	bodies []json.RawMessage
+}
+
+// add folds the metadata of a follow-up call into m, keeping m's request ID.
+func (m *ResponseMeta) add(other ResponseMeta) {
+	m.ElapsedWallClock += other.ElapsedWallClock
+	m.Attempts += other.Attempts
+	m.EstimatedCredits += other.EstimatedCredits
+	m.CacheHit = m.CacheHit && other.CacheHit
+	m.SchemaDrift = append(m.SchemaDrift, other.SchemaDrift...)
+	m.PIIRedactions += other.PIIRedactions
+	m.bodies = slices.Concat(m.rawBodies(), other.rawBodies())
+	// No single body matches a combined response.
+	m.raw = nil
+}
+
+// rawBodies returns the kept bodies of every call behind the response.
+func (m *ResponseMeta) rawBodies() []json.RawMessage {
+	if m.bodies != nil {
+		return m.bodies
+	}
+	if m.raw != nil {
+		return []json.RawMessage{m.raw}
+	}
+	return nil
+}
+
+// metaCarrier is implemented by responses exposing ResponseMeta.
+type metaCarrier interface {
+	responseMeta() *ResponseMeta
+}
+
+func (r *SearchResponse) responseMeta() *ResponseMeta  { return &r.Meta }
+func (r *ExtractResponse) responseMeta() *ResponseMeta { return &r.Meta }
+func (r *CrawlResponse) responseMeta() *ResponseMeta   { return &r.Meta }
+func (r *MapResponse) responseMeta() *ResponseMeta     { return &r.Meta }
+
+// Raw returns the response body as received, for fields the struct does not
+// model yet. It is nil unless Options.KeepRawResponses is set, and for
+// responses combined from several calls, such as a search with a FillResults
+// follow-up or the result of MergeResponses.
+func (r *SearchResponse) Raw() json.RawMessage { return r.Meta.raw }
+
+// Raw returns the response body as received; see SearchResponse.Raw.
+func (r *ExtractResponse) Raw() json.RawMessage { return r.Meta.raw }
+
+// Raw returns the response body as received; see SearchResponse.Raw.
+func (r *CrawlResponse) Raw() json.RawMessage { return r.Meta.raw }
+
+// Raw returns the response body as received; see SearchResponse.Raw.
+func (r *MapResponse) Raw() json.RawMessage { return r.Meta.raw }
+
+// estimateCredits applies the published per-endpoint pricing to a completed call.
+func estimateCredits(request, response any) float64 {
+	switch req := request.(type) {
+	case *SearchRequest:
+		if req.SearchDepth == string(SearchDepthAdvanced) {
+			return 2
+		}
+		return 1
+	case *ExtractRequest:
+		resp, ok := response.(*ExtractResponse)
+		if !ok {
+			return 0
+		}
+		return extractCredits(len(resp.Results), req.ExtractDepth)
+	case *MapRequest:
+		resp, ok := response.(*MapResponse)
+		if !ok {
+			return 0
+		}
+		return mapCredits(len(resp.Results), req.Instructions != "")
+	case *CrawlRequest:
+		resp, ok := response.(*CrawlResponse)
+		if !ok {
+			return 0
+		}
+		pages := len(resp.Results)
+		return mapCredits(pages, req.Instructions != "") + extractCredits(pages, req.ExtractDepth)
+	}
+	return 0
+}
+
+// extractCredits charges 1 credit (2 for advanced) per 5 successful extractions.
+func extractCredits(successes int, depth string) float64 {
+	perBatch := 1.0
+	if depth == string(ExtractDepthAdvanced) {
+		perBatch = 2
+	}
+	return math.Ceil(float64(successes)/5) * perBatch
+}
+
+// mapCredits charges 1 credit (2 with instructions) per 10 mapped pages.
+func mapCredits(pages int, withInstructions bool) float64 {
+	perBatch := 1.0
+	if withInstructions {
+		perBatch = 2
+	}
+	return math.Ceil(float64(pages)/10) * perBatch
+}
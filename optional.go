@@ -0,0 +1,52 @@
+package tavily
+
+import "math"
+
+// The zero value of an int option selects the client default. Assign one of
+// these sentinels to an option to ask for something else:
+//
+//	// Answer-only search: request no results at all.
+//	opts := &tavily.SearchOptions{IncludeAnswer: true, MaxResults: tavily.Zero}
+//
+// They apply to SearchOptions MaxResults, Days and Timeout, the Timeout of
+// ExtractOptions, and the MaxDepth, MaxBreadth, Limit and Timeout of
+// CrawlOptions and MapOptions. Days has no client default, so Unset and 0
+// both leave it out.
+const (
+	// Unset leaves the option out of the request so the API applies its own default.
+	Unset = math.MinInt
+	// Zero sends a literal 0.
+	Zero = math.MinInt + 1
+)
+
+// Int returns a pointer to v, for building request payloads by hand.
+func Int(v int) *int {
+	return &v
+}
+
+// resolveInt maps an int option to its request value. A nil result leaves the
+// field out of the request.
+func resolveInt(value, defaultValue int) *int {
+	switch value {
+	case Unset:
+		return nil
+	case Zero:
+		return Int(0)
+	case 0:
+		return Int(defaultValue)
+	}
+	return Int(value)
+}
+
+// optionalInt is resolveInt for options without a client default.
+func optionalInt(value int) *int {
+	if value == 0 {
+		return nil
+	}
+	return resolveInt(value, 0)
+}
+
+// isSentinel reports whether v is Unset or Zero, which range checks skip.
+func isSentinel(v int) bool {
+	return v == Unset || v == Zero
+}
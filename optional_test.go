@@ -0,0 +1,129 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestResolveInt(t *testing.T) {
+	tests := []struct {
+		name  string
+		value int
+		want  *int
+	}{
+		{"zero value uses default", 0, Int(60)},
+		{"explicit value", 30, Int(30)},
+		{"Zero sends 0", Zero, Int(0)},
+		{"Unset omits", Unset, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resolveInt(tt.value, 60)
+			if (got == nil) != (tt.want == nil) || got != nil && *got != *tt.want {
+				t.Errorf("resolveInt(%d, 60) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchExplicitZero(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "answer": "42", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	_, err := client.Search(context.Background(), "q", &SearchOptions{
+		IncludeAnswer: true,
+		MaxResults:    Zero,
+		Timeout:       Unset,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	if got, ok := body["max_results"]; !ok || got != float64(0) {
+		t.Errorf("max_results = %v (present %v), want 0", got, ok)
+	}
+	if got, ok := body["timeout"]; ok {
+		t.Errorf("timeout = %v, want it omitted", got)
+	}
+}
+
+func TestCacheKeyExplicitZero(t *testing.T) {
+	omitted, _ := CacheKey("/search", &SearchRequest{Query: "q"}, false)
+	five, _ := CacheKey("/search", &SearchRequest{Query: "q", MaxResults: Int(DefaultMaxResults)}, false)
+	zero, _ := CacheKey("/search", &SearchRequest{Query: "q", MaxResults: Int(0)}, false)
+
+	if omitted != five {
+		t.Errorf("CacheKey() differs for an omitted and a default max_results")
+	}
+	if zero == omitted {
+		t.Errorf("CacheKey() is the same for max_results 0 and an omitted max_results")
+	}
+
+	depthOmitted, _ := CacheKey("/crawl", &CrawlRequest{URL: "https://example.com"}, false)
+	depthZero, _ := CacheKey("/crawl", &CrawlRequest{URL: "https://example.com", MaxDepth: Int(0)}, false)
+	if depthZero == depthOmitted {
+		t.Errorf("CacheKey() is the same for max_depth 0 and an omitted max_depth")
+	}
+}
+
+func TestCrawlEdgeValues(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"base_url": "https://example.com", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	_, err := client.Crawl(context.Background(), "https://example.com", &CrawlOptions{
+		MaxDepth:   Zero,
+		MaxBreadth: Unset,
+		Preflight:  PreflightOff,
+	})
+	if err != nil {
+		t.Fatalf("Crawl() error = %v", err)
+	}
+
+	if got, ok := body["max_depth"]; !ok || got != float64(0) {
+		t.Errorf("max_depth = %v (present %v), want 0", got, ok)
+	}
+	if got, ok := body["max_breadth"]; ok {
+		t.Errorf("max_breadth = %v, want it omitted", got)
+	}
+	if got := body["limit"]; got != float64(50) {
+		t.Errorf("limit = %v, want the default 50", got)
+	}
+}
+
+func TestSearchDaysUnset(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	if _, err := client.Search(context.Background(), "q", &SearchOptions{Days: Unset, Within: 48 * time.Hour}); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got, ok := body["days"]; ok {
+		t.Errorf("days = %v, want it omitted", got)
+	}
+	if got := body["time_range"]; got != string(TimeRangeWeek) {
+		t.Errorf("time_range = %v, want %v", got, TimeRangeWeek)
+	}
+}
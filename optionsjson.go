@@ -0,0 +1,105 @@
+package tavily
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// OptionsType is the set of per-call option structs that can be stored as
+// JSON or YAML. Hooks such as SearchOptions.DomainScorer and
+// ExtractOptions.LocalFallback are not serialized and must be set in code.
+// Durations are written as strings like "48h", the form YAML decoders expect.
+type OptionsType interface {
+	SearchOptions | ExtractOptions | CrawlOptions | MapOptions
+}
+
+// ToJSON encodes opts for storage in a config system or a queue message.
+func ToJSON[T OptionsType](opts *T) ([]byte, error) {
+	return json.Marshal(opts)
+}
+
+// FromJSON decodes options written by ToJSON or by hand:
+//
+//	opts, err := tavily.FromJSON[tavily.SearchOptions](data)
+func FromJSON[T OptionsType](data []byte) (*T, error) {
+	var opts T
+	if err := json.Unmarshal(data, &opts); err != nil {
+		return nil, fmt.Errorf("failed to decode %T: %w", opts, err)
+	}
+	return &opts, nil
+}
+
+// jsonDuration encodes a time.Duration as a string and decodes either a
+// string or a number of nanoseconds.
+type jsonDuration time.Duration
+
+func (d jsonDuration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *jsonDuration) UnmarshalJSON(data []byte) error {
+	if !bytes.HasPrefix(data, []byte(`"`)) {
+		n, err := strconv.ParseInt(string(data), 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid duration %s", data)
+		}
+		*d = jsonDuration(n)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = jsonDuration(v)
+	return nil
+}
+
+func (o SearchOptions) MarshalJSON() ([]byte, error) {
+	type plain SearchOptions
+	return json.Marshal(struct {
+		plain
+		Within jsonDuration `json:"within,omitempty"`
+	}{plain(o), jsonDuration(o.Within)})
+}
+
+func (o *SearchOptions) UnmarshalJSON(data []byte) error {
+	type plain SearchOptions
+	aux := struct {
+		*plain
+		Within jsonDuration `json:"within,omitempty"`
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	o.Within = time.Duration(aux.Within)
+	return nil
+}
+
+func (o EnrichOptions) MarshalJSON() ([]byte, error) {
+	type plain EnrichOptions
+	return json.Marshal(struct {
+		plain
+		Timeout jsonDuration `json:"timeout,omitempty"`
+	}{plain(o), jsonDuration(o.Timeout)})
+}
+
+func (o *EnrichOptions) UnmarshalJSON(data []byte) error {
+	type plain EnrichOptions
+	aux := struct {
+		*plain
+		Timeout jsonDuration `json:"timeout,omitempty"`
+	}{plain: (*plain)(o)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	o.Timeout = time.Duration(aux.Timeout)
+	return nil
+}
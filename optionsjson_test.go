@@ -0,0 +1,90 @@
+package tavily
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOptionsJSONRoundTrip(t *testing.T) {
+	includeImages := true
+	search := &SearchOptions{
+		SearchDepth:    "advanced",
+		Topic:          "news",
+		MaxResults:     Zero,
+		IncludeDomains: []string{"go.dev"},
+		IncludeAnswer:  "advanced",
+		IncludeImages:  &includeImages,
+		Within:         48 * time.Hour,
+		Since:          time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+		Dedup:          true,
+		VerifyLinks:    &EnrichOptions{Concurrency: 4, Timeout: 3 * time.Second},
+	}
+
+	data, err := ToJSON(search)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	for _, want := range []string{`"search_depth":"advanced"`, `"within":"48h0m0s"`, `"timeout":"3s"`} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("ToJSON() = %s, want it to contain %s", data, want)
+		}
+	}
+
+	got, err := FromJSON[SearchOptions](data)
+	if err != nil {
+		t.Fatalf("FromJSON() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, search) {
+		t.Errorf("FromJSON(ToJSON()) = %+v, want %+v", got, search)
+	}
+
+	crawl := &CrawlOptions{MaxDepth: 3, Categories: []CrawlCategory{CategoryDocumentation}, Preflight: PreflightFail}
+	data, err = ToJSON(crawl)
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	gotCrawl, err := FromJSON[CrawlOptions](data)
+	if err != nil {
+		t.Fatalf("FromJSON() error = %v", err)
+	}
+	if !reflect.DeepEqual(gotCrawl, crawl) {
+		t.Errorf("FromJSON(ToJSON()) = %+v, want %+v", gotCrawl, crawl)
+	}
+}
+
+func TestOptionsFromJSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"duration string", `{"within": "36h"}`, 36 * time.Hour, false},
+		{"nanoseconds", `{"within": 60000000000}`, time.Minute, false},
+		{"bad duration", `{"within": "soon"}`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := FromJSON[SearchOptions]([]byte(tt.data))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("FromJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && got.Within != tt.want {
+				t.Errorf("FromJSON() Within = %v, want %v", got.Within, tt.want)
+			}
+		})
+	}
+}
+
+func TestOptionsJSONSkipsHooks(t *testing.T) {
+	data, err := ToJSON(&ExtractOptions{Format: "text", LocalFallback: BasicExtractor{}})
+	if err != nil {
+		t.Fatalf("ToJSON() error = %v", err)
+	}
+	if string(data) != `{"format":"text"}` {
+		t.Errorf("ToJSON() = %s, want %s", data, `{"format":"text"}`)
+	}
+}
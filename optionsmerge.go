@@ -0,0 +1,103 @@
+package tavily
+
+import "reflect"
+
+// Clone returns a deep copy of o. Slices and pointed-to values are copied;
+// hooks such as DomainScorer are shared.
+func (o *SearchOptions) Clone() *SearchOptions { return cloneOptions(o) }
+
+// Merge returns a copy of o overlaid with every field other sets to a non-zero
+// value, so layers compose from general to specific:
+//
+//	opts := global.Merge(tenant).Merge(perCall)
+//
+// Fields are replaced whole: slices are not appended and a non-nil Enrich
+// replaces the previous one. A zero field never overrides, so use a *bool
+// field or the Zero and Unset sentinels to override with a zero value.
+// Either side may be nil.
+func (o *SearchOptions) Merge(other *SearchOptions) *SearchOptions { return mergeOptions(o, other) }
+
+// Clone returns a deep copy of o; see SearchOptions.Clone.
+func (o *ExtractOptions) Clone() *ExtractOptions { return cloneOptions(o) }
+
+// Merge overlays other on a copy of o; see SearchOptions.Merge.
+func (o *ExtractOptions) Merge(other *ExtractOptions) *ExtractOptions { return mergeOptions(o, other) }
+
+// Clone returns a deep copy of o; see SearchOptions.Clone.
+func (o *CrawlOptions) Clone() *CrawlOptions { return cloneOptions(o) }
+
+// Merge overlays other on a copy of o; see SearchOptions.Merge.
+func (o *CrawlOptions) Merge(other *CrawlOptions) *CrawlOptions { return mergeOptions(o, other) }
+
+// Clone returns a deep copy of o; see SearchOptions.Clone.
+func (o *MapOptions) Clone() *MapOptions { return cloneOptions(o) }
+
+// Merge overlays other on a copy of o; see SearchOptions.Merge.
+func (o *MapOptions) Merge(other *MapOptions) *MapOptions { return mergeOptions(o, other) }
+
+// Clone returns a copy of o.
+func (o *EnrichOptions) Clone() *EnrichOptions { return cloneOptions(o) }
+
+// Merge overlays other on a copy of o; see SearchOptions.Merge.
+func (o *EnrichOptions) Merge(other *EnrichOptions) *EnrichOptions { return mergeOptions(o, other) }
+
+func cloneOptions[T any](o *T) *T {
+	if o == nil {
+		return nil
+	}
+	clone := new(T)
+	reflect.ValueOf(clone).Elem().Set(deepCopy(reflect.ValueOf(o).Elem()))
+	return clone
+}
+
+func mergeOptions[T any](base, other *T) *T {
+	if base == nil {
+		return cloneOptions(other)
+	}
+	merged := cloneOptions(base)
+	if other == nil {
+		return merged
+	}
+
+	src := reflect.ValueOf(other).Elem()
+	dst := reflect.ValueOf(merged).Elem()
+	for i := range src.NumField() {
+		if f := src.Field(i); !f.IsZero() && dst.Field(i).CanSet() {
+			dst.Field(i).Set(deepCopy(f))
+		}
+	}
+	return merged
+}
+
+// deepCopy copies slices, pointers and struct fields of v. Interfaces are
+// shared, which keeps hooks and immutable values like IncludeAnswer intact.
+func deepCopy(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			c.Index(i).Set(deepCopy(v.Index(i)))
+		}
+		return c
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+		c := reflect.New(v.Type().Elem())
+		c.Elem().Set(deepCopy(v.Elem()))
+		return c
+	case reflect.Struct:
+		c := reflect.New(v.Type()).Elem()
+		c.Set(v)
+		for i := range v.NumField() {
+			if c.Field(i).CanSet() {
+				c.Field(i).Set(deepCopy(v.Field(i)))
+			}
+		}
+		return c
+	}
+	return v
+}
@@ -0,0 +1,72 @@
+package tavily
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestOptionsClone(t *testing.T) {
+	include := true
+	opts := &SearchOptions{
+		IncludeDomains: []string{"go.dev"},
+		IncludeImages:  &include,
+		Enrich:         &EnrichOptions{Timeout: time.Second},
+		DomainScorer:   &DomainLists{Allow: []string{"go.dev"}},
+	}
+
+	clone := opts.Clone()
+	if !reflect.DeepEqual(clone, opts) {
+		t.Fatalf("Clone() = %+v, want %+v", clone, opts)
+	}
+
+	clone.IncludeDomains[0] = "example.com"
+	*clone.IncludeImages = false
+	clone.Enrich.Timeout = time.Minute
+	if opts.IncludeDomains[0] != "go.dev" || !*opts.IncludeImages || opts.Enrich.Timeout != time.Second {
+		t.Errorf("mutating the clone changed the original: %+v", opts)
+	}
+	if clone.DomainScorer != opts.DomainScorer {
+		t.Errorf("Clone() copied the DomainScorer hook, want it shared")
+	}
+
+	if (*SearchOptions)(nil).Clone() != nil {
+		t.Errorf("nil Clone() = non-nil, want nil")
+	}
+}
+
+func TestOptionsMerge(t *testing.T) {
+	global := &CrawlOptions{MaxDepth: 2, Limit: 100, Format: "markdown", ExcludePaths: []string{"/admin"}}
+	tenant := &CrawlOptions{Limit: 20, SelectDomains: []string{"docs.example.com"}}
+	call := &CrawlOptions{MaxDepth: Zero, ExcludePaths: []string{"/blog"}}
+
+	got := global.Merge(tenant).Merge(call)
+	want := &CrawlOptions{
+		MaxDepth:      Zero,
+		Limit:         20,
+		Format:        "markdown",
+		ExcludePaths:  []string{"/blog"},
+		SelectDomains: []string{"docs.example.com"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Merge() = %+v, want %+v", got, want)
+	}
+	if global.Limit != 100 || global.MaxDepth != 2 {
+		t.Errorf("Merge() modified the receiver: %+v", global)
+	}
+
+	tests := []struct {
+		name        string
+		base, other *MapOptions
+		want        *MapOptions
+	}{
+		{"nil receiver", nil, &MapOptions{Limit: 5}, &MapOptions{Limit: 5}},
+		{"nil other", &MapOptions{Limit: 5}, nil, &MapOptions{Limit: 5}},
+		{"both nil", nil, nil, nil},
+	}
+	for _, tt := range tests {
+		if got := tt.base.Merge(tt.other); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: Merge() = %+v, want %+v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PageStore remembers the result URLs returned for each page of a query so that
+// SearchPage can exclude them when fetching later pages. The query passed in is
+// a key made of the normalized query text and the options that change which
+// results come back. Implementations must be safe for concurrent use.
+type PageStore interface {
+	// Page returns the URLs stored for page of query.
+	Page(query string, page int) ([]string, bool)
+	// SetPage stores the URLs returned for page of query.
+	SetPage(query string, page int, urls []string)
+}
+
+// MemoryPageStore is an in-process PageStore. The zero value is ready to use.
+type MemoryPageStore struct {
+	mu    sync.RWMutex
+	pages map[string]map[int][]string
+}
+
+// Page implements PageStore.
+func (s *MemoryPageStore) Page(query string, page int) ([]string, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	urls, ok := s.pages[query][page]
+	return urls, ok
+}
+
+// SetPage implements PageStore.
+func (s *MemoryPageStore) SetPage(query string, page int, urls []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.pages == nil {
+		s.pages = make(map[string]map[int][]string)
+	}
+	if s.pages[query] == nil {
+		s.pages[query] = make(map[int][]string)
+	}
+	s.pages[query][page] = slices.Clone(urls)
+}
+
+// SearchPage emulates paging, which the API lacks, by excluding the domains and
+// URLs returned on earlier pages of the same query. Pages start at 1.
+// Earlier pages missing from the client's PageStore are fetched first.
+func (c *Client) SearchPage(ctx context.Context, query string, page int, opts *SearchOptions) (*SearchResponse, error) {
+	if page < 1 {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    fmt.Sprintf("page must be at least 1, got %d", page),
+		}
+	}
+
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	key := pageKey(query, withDefaults(opts, callOptionsFrom(ctx).Search))
+	var previous []string
+	for p := 1; p < page; p++ {
+		urls, ok := c.pages.Page(key, p)
+		if !ok {
+			resp, err := c.searchExcluding(ctx, query, opts, previous)
+			if err != nil {
+				return nil, err
+			}
+			urls = resultURLs(resp.Results)
+			c.pages.SetPage(key, p, urls)
+		}
+		previous = append(previous, urls...)
+	}
+
+	resp, err := c.searchExcluding(ctx, query, opts, previous)
+	if err != nil {
+		return nil, err
+	}
+	c.pages.SetPage(key, page, resultURLs(resp.Results))
+	return resp, nil
+}
+
+func (c *Client) searchExcluding(ctx context.Context, query string, opts *SearchOptions, seenURLs []string) (*SearchResponse, error) {
+	pageOpts := *opts
+	pageOpts.ExcludeDomains = slices.Clone(opts.ExcludeDomains)
+	for _, u := range seenURLs {
+		if host := domainOf(u); !slices.Contains(pageOpts.ExcludeDomains, host) {
+			pageOpts.ExcludeDomains = append(pageOpts.ExcludeDomains, host)
+		}
+	}
+
+	resp, err := c.Search(ctx, query, &pageOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Results = slices.DeleteFunc(resp.Results, func(r SearchResult) bool {
+		return slices.Contains(seenURLs, r.URL)
+	})
+	return resp, nil
+}
+
+// pageKey identifies the pages of query searched with opts: the normalized
+// query followed by a canonical encoding of the topic, domains, time window,
+// depth and country, so the same query with other filters pages separately.
+func pageKey(query string, opts *SearchOptions) string {
+	domains := func(list []string) []string {
+		out := make([]string, 0, len(list))
+		for _, d := range list {
+			out = append(out, strings.ToLower(strings.TrimSpace(d)))
+		}
+		slices.Sort(out)
+		return slices.Compact(out)
+	}
+	days := opts.Days
+	if days == Unset {
+		days = 0
+	}
+	scope, _ := json.Marshal(struct {
+		Topic          string        `json:"topic"`
+		Depth          string        `json:"depth,omitempty"`
+		Country        string        `json:"country,omitempty"`
+		IncludeDomains []string      `json:"include,omitempty"`
+		ExcludeDomains []string      `json:"exclude,omitempty"`
+		TimeRange      string        `json:"time_range,omitempty"`
+		Days           int           `json:"days,omitempty"`
+		Within         time.Duration `json:"within,omitempty"`
+		Since          time.Time     `json:"since,omitzero"`
+		Until          time.Time     `json:"until,omitzero"`
+	}{
+		Topic:          strings.ToLower(defaultString(opts.Topic, DefaultTopic)),
+		Depth:          strings.ToLower(opts.SearchDepth),
+		Country:        strings.ToLower(opts.Country),
+		IncludeDomains: domains(opts.IncludeDomains),
+		ExcludeDomains: domains(opts.ExcludeDomains),
+		TimeRange:      opts.TimeRange,
+		Days:           days,
+		Within:         opts.Within,
+		Since:          opts.Since.UTC(),
+		Until:          opts.Until.UTC(),
+	})
+	return strings.ToLower(strings.Join(strings.Fields(query), " ")) + " " + string(scope)
+}
+
+func resultURLs(results []SearchResult) []string {
+	urls := make([]string, len(results))
+	for i, r := range results {
+		urls[i] = r.URL
+	}
+	return urls
+}
@@ -0,0 +1,106 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"runtime/debug"
+)
+
+// PanicPolicy controls what happens when a user-supplied hook panics. Hooks
+// are Cache, ContentFilter, DomainScorer, QueryRewriter, QueryDeriver,
+// FollowUpGenerator, PIIDetector, AuditSink, ReleaseHandler,
+// RetryPolicy.Decide, RedirectPolicy.OnRedirect, Translator, ContentExtractor
+// and ContextFormatter implementations.
+type PanicPolicy string
+
+const (
+	// PanicAbort fails the call with a *PanicError.
+	PanicAbort PanicPolicy = ""
+	// PanicContinue logs the panic and carries on without the hook for that
+	// invocation: a cache lookup misses, a filter or scorer drops the result
+	// rather than let unchecked content through, a query is sent unrewritten,
+	// a query or follow-ups are derived heuristically, text being scrubbed of
+	// PII is cleared, an audit record or release event is dropped, a retry
+	// decision stops retrying, a redirect is followed, a language is left out
+	// of SearchInLanguages, a local extraction fails and a context source is
+	// left out.
+	PanicContinue PanicPolicy = "continue"
+)
+
+// PanicError reports a panic recovered from a user-supplied hook.
+type PanicError struct {
+	// Hook names the hook, e.g. "ContentFilter".
+	Hook string
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the goroutine stack at the time of the panic.
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("%s panicked: %v", e.Hook, e.Value)
+}
+
+// Unwrap returns the panic value when it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// runHook calls fn, recovering a panic into a logged *PanicError.
+func (c *Client) runHook(ctx context.Context, hook string, fn func()) (err error) {
+	defer func() {
+		if v := recover(); v != nil {
+			perr := &PanicError{Hook: hook, Value: v, Stack: debug.Stack()}
+			c.logDebug(ctx, "tavily hook panicked", "hook", hook, "panic", fmt.Sprint(v), "stack", string(perr.Stack))
+			err = perr
+		}
+	}()
+	fn()
+	return nil
+}
+
+// abortOnPanic reports whether a hook panic fails the call.
+func (c *Client) abortOnPanic() bool {
+	return c.panics != PanicContinue
+}
+
+// guardedFilter runs a ContentFilter under the client's PanicPolicy. A
+// panicking check blocks the content, with the panic as the reason; under
+// PanicAbort the first panic is kept in err for the caller to return.
+type guardedFilter struct {
+	c      *Client
+	ctx    context.Context
+	filter ContentFilter
+	err    error
+}
+
+func (g *guardedFilter) Check(text string) (reason string, blocked bool) {
+	if err := g.c.runHook(g.ctx, "ContentFilter", func() { reason, blocked = g.filter.Check(text) }); err != nil {
+		if g.err == nil && g.c.abortOnPanic() {
+			g.err = err
+		}
+		return err.Error(), true
+	}
+	return reason, blocked
+}
+
+// guardedScorer is the DomainScorer counterpart of guardedFilter. A panicking
+// scorer rejects the domain.
+type guardedScorer struct {
+	c      *Client
+	ctx    context.Context
+	scorer DomainScorer
+	err    error
+}
+
+func (g *guardedScorer) ScoreDomain(domain string) (score float64) {
+	if err := g.c.runHook(g.ctx, "DomainScorer", func() { score = g.scorer.ScoreDomain(domain) }); err != nil {
+		if g.err == nil && g.c.abortOnPanic() {
+			g.err = err
+		}
+		return math.Inf(-1)
+	}
+	return score
+}
@@ -0,0 +1,187 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+type panickingFilter struct{}
+
+func (panickingFilter) Check(string) (string, bool) { panic("filter exploded") }
+
+type panickingExtractor struct{}
+
+func (panickingExtractor) ExtractContent(context.Context, string, string, []byte) (string, error) {
+	panic(errors.New("extractor exploded"))
+}
+
+type panickingFormatter struct{}
+
+func (panickingFormatter) FormatHeader(w io.Writer, query string) error {
+	_, err := io.WriteString(w, "Q: "+query+"\n")
+	return err
+}
+
+func (panickingFormatter) FormatSource(w io.Writer, n int, r SearchResult) error {
+	io.WriteString(w, "partial")
+	panic("formatter exploded")
+}
+
+func TestHookPanicPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://a.com", "title": "A", "content": "Alpha.", "score": 0.9}
+		]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		policy  PanicPolicy
+		wantErr bool
+	}{
+		{"abort", PanicAbort, true},
+		{"continue", PanicContinue, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("tvly-test-key", &Options{
+				BaseURL:       server.URL,
+				ContentFilter: panickingFilter{},
+				PanicPolicy:   tt.policy,
+			})
+
+			result, err := client.Search(context.Background(), "test", &SearchOptions{
+				DomainScorer: DomainScorerFunc(func(string) float64 { panic("scorer exploded") }),
+			})
+			if !tt.wantErr {
+				if err != nil {
+					t.Fatalf("Search() error = %v", err)
+				}
+				if len(result.Results) != 0 {
+					t.Errorf("Search() results = %v, want the unchecked result dropped", resultURLList(result.Results))
+				}
+
+				result, err = client.Search(context.Background(), "test", nil)
+				if err != nil {
+					t.Fatalf("Search() error = %v", err)
+				}
+				if len(result.Results) != 0 || len(result.FilteredContent) != 1 ||
+					!strings.Contains(result.FilteredContent[0].Reason, "ContentFilter panicked") {
+					t.Errorf("Search() results = %v, filtered = %+v, want the result blocked by the panic",
+						resultURLList(result.Results), result.FilteredContent)
+				}
+				return
+			}
+
+			var perr *PanicError
+			if !errors.As(err, &perr) {
+				t.Fatalf("Search() error = %v, want *PanicError", err)
+			}
+			if perr.Hook != "DomainScorer" {
+				t.Errorf("PanicError.Hook = %v, want %v", perr.Hook, "DomainScorer")
+			}
+			if len(perr.Stack) == 0 {
+				t.Error("PanicError.Stack is empty")
+			}
+		})
+	}
+}
+
+func TestExtractorPanicInWorker(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`<p>page</p>`))
+	}))
+	defer site.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [], "failed_results": [{"url": "` + site.URL + `", "error": "blocked"}]}`))
+	}))
+	defer api.Close()
+
+	opts := &ExtractOptions{LocalFallback: panickingExtractor{}}
+
+	client := New("tvly-test-key", &Options{BaseURL: api.URL})
+	_, err := client.Extract(context.Background(), []string{site.URL}, opts)
+	var perr *PanicError
+	if !errors.As(err, &perr) || perr.Hook != "ContentExtractor" {
+		t.Fatalf("Extract() error = %v, want ContentExtractor *PanicError", err)
+	}
+	if !strings.Contains(err.Error(), "extractor exploded") {
+		t.Errorf("Extract() error = %v, want the panic value", err)
+	}
+
+	client = New("tvly-test-key", &Options{BaseURL: api.URL, PanicPolicy: PanicContinue})
+	result, err := client.Extract(context.Background(), []string{site.URL}, opts)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if len(result.FailedResults) != 1 {
+		t.Errorf("FailedResults = %+v, want the URL to stay failed", result.FailedResults)
+	}
+}
+
+func TestRetryDecidePanic(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Retry: &RetryPolicy{Decide: func(RetryAttempt) (time.Duration, bool) {
+			panic("decide exploded")
+		}},
+	})
+
+	_, err := client.Search(context.Background(), "test", nil)
+	var perr *PanicError
+	if !errors.As(err, &perr) || perr.Hook != "RetryPolicy.Decide" {
+		t.Fatalf("Search() error = %v, want RetryPolicy.Decide *PanicError", err)
+	}
+	if calls != 1 {
+		t.Errorf("calls = %v, want %v", calls, 1)
+	}
+}
+
+func TestContextFormatterPanic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": [{"url": "https://a.com", "content": "Alpha.", "score": 0.9}]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		policy  PanicPolicy
+		want    string
+		wantErr bool
+	}{
+		{PanicAbort, "", true},
+		{PanicContinue, "Q: q\n", false},
+	}
+	for _, tt := range tests {
+		client := New("tvly-test-key", &Options{BaseURL: server.URL, PanicPolicy: tt.policy})
+		r, err := client.SearchContext(context.Background(), "q", &ContextOptions{Formatter: panickingFormatter{}})
+		if err != nil {
+			t.Fatalf("SearchContext() error = %v", err)
+		}
+		got, err := io.ReadAll(r)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ReadAll() policy %q error = %v, wantErr %v", tt.policy, err, tt.wantErr)
+		}
+		if !tt.wantErr && string(got) != tt.want {
+			t.Errorf("ReadAll() policy %q = %q, want %q", tt.policy, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package tavily
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PathGroup is a cluster of mapped URLs sharing a path prefix.
+type PathGroup struct {
+	// Prefix is the shared path, e.g. "/docs/api". URLs on a host other than
+	// the map's base URL are grouped under that host first.
+	Prefix string
+	// Count is the number of URLs at or below Prefix.
+	Count int
+	// MinDepth, MaxDepth and AverageDepth describe the path depth (number of
+	// segments) of those URLs.
+	MinDepth     int
+	MaxDepth     int
+	AverageDepth float64
+	// Children are the sub-prefixes one segment deeper, largest first.
+	Children []PathGroup
+}
+
+type pathNode struct {
+	prefix   string
+	count    int
+	depths   []int
+	children map[string]*pathNode
+}
+
+func (n *pathNode) child(key, prefix string) *pathNode {
+	if n.children == nil {
+		n.children = make(map[string]*pathNode)
+	}
+	c, ok := n.children[key]
+	if !ok {
+		c = &pathNode{prefix: prefix}
+		n.children[key] = c
+	}
+	return c
+}
+
+// PathGroups clusters the mapped URLs into a tree of path prefixes with
+// counts and depth statistics, largest groups first, to help choose
+// SelectPaths and ExcludePaths for a crawl. URLs at the site root are
+// grouped under "/".
+func (r *MapResponse) PathGroups() []PathGroup {
+	base, _ := url.Parse(r.BaseURL)
+	if base != nil && base.Host == "" {
+		base, _ = url.Parse("https://" + r.BaseURL)
+	}
+
+	root := &pathNode{}
+	for _, raw := range r.Results {
+		u, err := url.Parse(raw)
+		if err != nil {
+			continue
+		}
+		segs := pathSegments(u.Path)
+		depth := len(segs)
+
+		node := root
+		if base != nil && base.Host != "" && u.Host != "" && !sameHost(base, u) {
+			node = node.child(u.Host, u.Host)
+			node.count++
+			node.depths = append(node.depths, depth)
+		}
+		if depth == 0 {
+			node = node.child("/", "/")
+			node.count++
+			node.depths = append(node.depths, depth)
+			continue
+		}
+		prefix := ""
+		for _, seg := range segs {
+			prefix += "/" + seg
+			node = node.child(seg, prefix)
+			node.count++
+			node.depths = append(node.depths, depth)
+		}
+	}
+	return root.groups()
+}
+
+func (n *pathNode) groups() []PathGroup {
+	groups := make([]PathGroup, 0, len(n.children))
+	for _, c := range n.children {
+		g := PathGroup{
+			Prefix:   c.prefix,
+			Count:    c.count,
+			MinDepth: slices.Min(c.depths),
+			MaxDepth: slices.Max(c.depths),
+			Children: c.groups(),
+		}
+		total := 0
+		for _, d := range c.depths {
+			total += d
+		}
+		g.AverageDepth = float64(total) / float64(len(c.depths))
+		groups = append(groups, g)
+	}
+	slices.SortFunc(groups, func(a, b PathGroup) int {
+		return cmp.Or(cmp.Compare(b.Count, a.Count), cmp.Compare(a.Prefix, b.Prefix))
+	})
+	return groups
+}
+
+// WritePathTree prints groups as an indented tree with counts and depth
+// ranges. maxLevel limits how many levels are printed; maxLevel <= 0 prints
+// all of them.
+func WritePathTree(w io.Writer, groups []PathGroup, maxLevel int) error {
+	return writePathTree(w, groups, "", 1, maxLevel)
+}
+
+func writePathTree(w io.Writer, groups []PathGroup, indent string, level, maxLevel int) error {
+	for i, g := range groups {
+		branch, next := "├── ", "│   "
+		if i == len(groups)-1 {
+			branch, next = "└── ", "    "
+		}
+
+		depth := fmt.Sprintf("depth %d", g.MinDepth)
+		if g.MaxDepth != g.MinDepth {
+			depth = fmt.Sprintf("depth %d-%d", g.MinDepth, g.MaxDepth)
+		}
+		if _, err := fmt.Fprintf(w, "%s%s%s (%d, %s)\n", indent, branch, g.Prefix, g.Count, depth); err != nil {
+			return err
+		}
+
+		if maxLevel > 0 && level >= maxLevel {
+			continue
+		}
+		if err := writePathTree(w, g.Children, indent+next, level+1, maxLevel); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// String renders g and its children with WritePathTree.
+func (g PathGroup) String() string {
+	var b strings.Builder
+	WritePathTree(&b, []PathGroup{g}, 0)
+	return b.String()
+}
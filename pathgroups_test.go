@@ -0,0 +1,79 @@
+package tavily
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapPathGroups(t *testing.T) {
+	resp := &MapResponse{
+		BaseURL: "docs.example.com",
+		Results: []string{
+			"https://docs.example.com/",
+			"https://docs.example.com/docs/intro",
+			"https://docs.example.com/docs/api/search",
+			"https://docs.example.com/docs/api/extract",
+			"https://docs.example.com/blog/post",
+			"https://github.com/example/repo",
+		},
+	}
+
+	groups := resp.PathGroups()
+	if len(groups) != 4 {
+		t.Fatalf("len(PathGroups()) = %d, want 4", len(groups))
+	}
+
+	docs := groups[0]
+	if docs.Prefix != "/docs" || docs.Count != 3 || docs.MinDepth != 2 || docs.MaxDepth != 3 {
+		t.Errorf("groups[0] = %s %d %d-%d, want /docs 3 2-3", docs.Prefix, docs.Count, docs.MinDepth, docs.MaxDepth)
+	}
+	if want := 8.0 / 3; docs.AverageDepth != want {
+		t.Errorf("AverageDepth = %v, want %v", docs.AverageDepth, want)
+	}
+	if len(docs.Children) != 2 || docs.Children[0].Prefix != "/docs/api" || docs.Children[0].Count != 2 {
+		t.Errorf("docs.Children = %+v, want /docs/api (2) first", docs.Children)
+	}
+
+	var prefixes []string
+	for _, g := range groups[1:] {
+		prefixes = append(prefixes, g.Prefix)
+	}
+	if got := strings.Join(prefixes, ","); got != "/,/blog,github.com" {
+		t.Errorf("remaining prefixes = %s, want /,/blog,github.com", got)
+	}
+	if gh := groups[3]; len(gh.Children) != 1 || gh.Children[0].Prefix != "/example" {
+		t.Errorf("github.com children = %+v, want /example", gh.Children)
+	}
+}
+
+func TestWritePathTree(t *testing.T) {
+	resp := &MapResponse{
+		BaseURL: "https://example.com",
+		Results: []string{
+			"https://example.com/docs/a",
+			"https://example.com/docs/b/c",
+			"https://example.com/blog/x",
+		},
+	}
+
+	var b strings.Builder
+	if err := WritePathTree(&b, resp.PathGroups(), 0); err != nil {
+		t.Fatalf("WritePathTree() error = %v", err)
+	}
+	want := `├── /docs (2, depth 2-3)
+│   ├── /docs/a (1, depth 2)
+│   └── /docs/b (1, depth 3)
+│       └── /docs/b/c (1, depth 3)
+└── /blog (1, depth 2)
+    └── /blog/x (1, depth 2)
+`
+	if b.String() != want {
+		t.Errorf("WritePathTree() =\n%s\nwant\n%s", b.String(), want)
+	}
+
+	b.Reset()
+	WritePathTree(&b, resp.PathGroups(), 1)
+	if got := strings.Count(b.String(), "\n"); got != 2 {
+		t.Errorf("WritePathTree(maxLevel 1) printed %d lines, want 2", got)
+	}
+}
@@ -0,0 +1,149 @@
+package tavily
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// PathsFromGlobs converts shell-style globs into the regex patterns expected by
+// SelectPaths and ExcludePaths. A single "*" matches within one path segment,
+// "**" matches across segments and "?" matches one non-separator character.
+func PathsFromGlobs(globs ...string) ([]string, error) {
+	patterns := make([]string, 0, len(globs))
+	for _, glob := range globs {
+		if glob == "" {
+			return nil, fmt.Errorf("invalid glob: empty pattern")
+		}
+		pattern := globToRegex(glob)
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid glob %q: %w", glob, err)
+		}
+		patterns = append(patterns, pattern)
+	}
+	return patterns, nil
+}
+
+// PathsFromRegex validates regex patterns for SelectPaths and ExcludePaths so
+// that typos surface locally instead of as a failed crawl.
+func PathsFromRegex(patterns ...string) ([]string, error) {
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return nil, fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+		}
+	}
+	return patterns, nil
+}
+
+func globToRegex(glob string) string {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				b.WriteString(".*")
+				i++
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return b.String()
+}
+
+// PathMatcher evaluates path and domain selectors locally, so callers can check
+// which URLs a crawl would admit before spending credits on it.
+type PathMatcher struct {
+	selectPaths    []*regexp.Regexp
+	excludePaths   []*regexp.Regexp
+	selectDomains  []*regexp.Regexp
+	excludeDomains []*regexp.Regexp
+}
+
+// NewPathMatcher compiles the selectors from CrawlOptions.
+func NewPathMatcher(opts *CrawlOptions) (*PathMatcher, error) {
+	if opts == nil {
+		opts = &CrawlOptions{}
+	}
+
+	var m PathMatcher
+	var err error
+	if m.selectPaths, err = compilePatterns(opts.SelectPaths); err != nil {
+		return nil, err
+	}
+	if m.excludePaths, err = compilePatterns(opts.ExcludePaths); err != nil {
+		return nil, err
+	}
+	if m.selectDomains, err = compilePatterns(opts.SelectDomains); err != nil {
+		return nil, err
+	}
+	if m.excludeDomains, err = compilePatterns(opts.ExcludeDomains); err != nil {
+		return nil, err
+	}
+	return &m, nil
+}
+
+func compilePatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path pattern %q: %w", pattern, err)
+		}
+		compiled = append(compiled, re)
+	}
+	return compiled, nil
+}
+
+// Allows reports whether rawURL passes the select and exclude selectors.
+func (m *PathMatcher) Allows(rawURL string) bool {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return false
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	host := strings.ToLower(u.Hostname())
+
+	if len(m.selectDomains) > 0 && !matchAny(m.selectDomains, host) {
+		return false
+	}
+	if matchAny(m.excludeDomains, host) {
+		return false
+	}
+	if len(m.selectPaths) > 0 && !matchAny(m.selectPaths, path) {
+		return false
+	}
+	return !matchAny(m.excludePaths, path)
+}
+
+// Filter splits urls into those the selectors admit and those they reject.
+func (m *PathMatcher) Filter(urls []string) (admitted, rejected []string) {
+	for _, u := range urls {
+		if m.Allows(u) {
+			admitted = append(admitted, u)
+		} else {
+			rejected = append(rejected, u)
+		}
+	}
+	return admitted, rejected
+}
+
+func matchAny(patterns []*regexp.Regexp, s string) bool {
+	for _, re := range patterns {
+		if re.MatchString(s) {
+			return true
+		}
+	}
+	return false
+}
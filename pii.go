@@ -0,0 +1,227 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// PIIMatch is a span of personal data found by a PIIDetector.
+type PIIMatch struct {
+	// Kind names the data, e.g. "email"; it appears in the placeholder.
+	Kind       string
+	Start, End int
+}
+
+// PIIDetector finds personal data in text. Implementations must be safe for
+// concurrent use.
+type PIIDetector interface {
+	DetectPII(text string) []PIIMatch
+}
+
+// PatternDetector is a PIIDetector reporting the matches of Pattern, optionally
+// confirmed by Valid, as Kind.
+type PatternDetector struct {
+	Kind    string
+	Pattern *regexp.Regexp
+	// Valid, if set, rejects matches that only look like the data.
+	Valid func(match string) bool
+}
+
+// DetectPII implements PIIDetector.
+func (d *PatternDetector) DetectPII(text string) []PIIMatch {
+	var matches []PIIMatch
+	for _, loc := range d.Pattern.FindAllStringIndex(text, -1) {
+		if d.Valid == nil || d.Valid(text[loc[0]:loc[1]]) {
+			matches = append(matches, PIIMatch{Kind: d.Kind, Start: loc[0], End: loc[1]})
+		}
+	}
+	return matches
+}
+
+// Built-in detectors, used by a PIIScrubber without Detectors.
+var (
+	EmailDetector = &PatternDetector{
+		Kind:    "email",
+		Pattern: regexp.MustCompile(`[A-Za-z0-9._%+-]+@[A-Za-z0-9-]+(?:\.[A-Za-z0-9-]+)*\.[A-Za-z]{2,}`),
+	}
+	// CardDetector finds 13 to 19 digit numbers passing the Luhn check.
+	CardDetector = &PatternDetector{
+		Kind:    "card",
+		Pattern: regexp.MustCompile(`\b(?:\d[ -]?){12,18}\d\b`),
+		Valid:   luhnValid,
+	}
+	// PhoneDetector finds phone numbers written in groups, with an optional
+	// country code, e.g. "+1 (555) 123-4567" or "030 1234 5678".
+	PhoneDetector = &PatternDetector{
+		Kind: "phone",
+		// The optional tail catches longer digit runs, such as card numbers,
+		// so they can be rejected rather than partly matched.
+		Pattern: regexp.MustCompile(`(?:\+\d{1,3}[\s.-]?)?(?:\(\d{2,4}\)|\b\d{2,4})[\s.-]?\d{3,4}[\s.-]?\d{3,4}\b(?:[\s.-]\d)?`),
+		Valid: func(match string) bool {
+			// A phone number ends in a group of digits, never in a lone one.
+			if strings.ContainsAny(match[len(match)-2:len(match)-1], " \t\n\r.-") {
+				return false
+			}
+			n := digitCount(match)
+			return n >= 9 && n <= 15
+		},
+	}
+)
+
+// PIIScrubber replaces personal data in content returned by the API with a
+// placeholder such as "[EMAIL]", for teams that must not store it or pass it
+// to a model. The zero value uses the built-in detectors.
+type PIIScrubber struct {
+	// Detectors defaults to EmailDetector, CardDetector and PhoneDetector.
+	// Where matches overlap, the earliest detector listed wins.
+	Detectors []PIIDetector
+}
+
+// Scrub returns text with every detected span replaced by "[KIND]", and the
+// number of spans replaced.
+func (s *PIIScrubber) Scrub(text string) (string, int) {
+	detectors := s.Detectors
+	if len(detectors) == 0 {
+		detectors = []PIIDetector{EmailDetector, CardDetector, PhoneDetector}
+	}
+
+	var found []PIIMatch
+	for _, d := range detectors {
+		for _, m := range d.DetectPII(text) {
+			overlaps := slices.ContainsFunc(found, func(f PIIMatch) bool {
+				return m.Start < f.End && f.Start < m.End
+			})
+			if !overlaps && m.Start >= 0 && m.Start < m.End && m.End <= len(text) {
+				found = append(found, m)
+			}
+		}
+	}
+	if len(found) == 0 {
+		return text, 0
+	}
+	slices.SortFunc(found, func(a, b PIIMatch) int { return a.Start - b.Start })
+
+	var b strings.Builder
+	last := 0
+	for _, m := range found {
+		b.WriteString(text[last:m.Start])
+		b.WriteString("[" + strings.ToUpper(m.Kind) + "]")
+		last = m.End
+	}
+	b.WriteString(text[last:])
+	return b.String(), len(found)
+}
+
+// scrubPII applies the client's PIIScrubber to text, recording replacements
+// in meta. Under PanicContinue a panicking detector clears the text rather
+// than let unscrubbed data through.
+func (c *Client) scrubPII(ctx context.Context, meta *ResponseMeta, text *string) error {
+	if c.pii == nil || *text == "" {
+		return nil
+	}
+	var scrubbed string
+	var n int
+	if err := c.runHook(ctx, "PIIDetector", func() { scrubbed, n = c.pii.Scrub(*text) }); err != nil {
+		if c.abortOnPanic() {
+			return err
+		}
+		*text = ""
+		return nil
+	}
+	*text = scrubbed
+	meta.PIIRedactions += n
+	return nil
+}
+
+// piiFields are the response fields holding page or generated text; URLs and
+// other fields are left alone.
+var piiFields = []string{"answer", "title", "content", "raw_content"}
+
+// scrubResponseBody applies the client's PIIScrubber to the answer and to the
+// title, content and raw content of every result in a response body, before
+// the body is cached, kept for Raw or decoded. It returns the body unchanged
+// when nothing was replaced, and the number of spans replaced.
+func (c *Client) scrubResponseBody(ctx context.Context, data []byte) ([]byte, int, error) {
+	if c.pii == nil || len(data) == 0 {
+		return data, 0, nil
+	}
+	var body map[string]any
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&body); err != nil {
+		// Not an object; decoding the response reports it.
+		return data, 0, nil
+	}
+
+	var meta ResponseMeta
+	changed := false
+	scrubFields := func(obj map[string]any) error {
+		for _, field := range piiFields {
+			text, ok := obj[field].(string)
+			if !ok {
+				continue
+			}
+			original := text
+			if err := c.scrubPII(ctx, &meta, &text); err != nil {
+				return err
+			}
+			obj[field] = text
+			changed = changed || text != original
+		}
+		return nil
+	}
+
+	if err := scrubFields(body); err != nil {
+		return nil, 0, err
+	}
+	results, _ := body["results"].([]any)
+	for _, r := range results {
+		if obj, ok := r.(map[string]any); ok {
+			if err := scrubFields(obj); err != nil {
+				return nil, 0, err
+			}
+		}
+	}
+	if !changed {
+		return data, 0, nil
+	}
+	scrubbed, err := json.Marshal(body)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to encode scrubbed response: %w", err)
+	}
+	return scrubbed, meta.PIIRedactions, nil
+}
+
+func luhnValid(number string) bool {
+	sum, n := 0, 0
+	for i := len(number) - 1; i >= 0; i-- {
+		ch := number[i]
+		if ch < '0' || ch > '9' {
+			continue
+		}
+		d := int(ch - '0')
+		if n%2 == 1 {
+			if d *= 2; d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		n++
+	}
+	return n >= 13 && n <= 19 && sum%10 == 0
+}
+
+func digitCount(s string) int {
+	n := 0
+	for i := range len(s) {
+		if s[i] >= '0' && s[i] <= '9' {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,158 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestPIIScrubber(t *testing.T) {
+	tests := []struct {
+		name      string
+		scrubber  *PIIScrubber
+		text      string
+		want      string
+		wantCount int
+	}{
+		{"email", &PIIScrubber{}, "Mail jane.doe@example.co.uk today.", "Mail [EMAIL] today.", 1},
+		{"phone", &PIIScrubber{}, "Call +1 (555) 123-4567 or 030 1234 5678.", "Call [PHONE] or [PHONE].", 2},
+		{"card", &PIIScrubber{}, "Card 4111 1111 1111 1111 on file.", "Card [CARD] on file.", 1},
+		{"not a card", &PIIScrubber{}, "Order 4111 1111 1111 1112 shipped.", "Order 4111 1111 1111 1112 shipped.", 0},
+		{"dates and years", &PIIScrubber{}, "Released 2024-01-15, version 1.24.3 in 2025.", "Released 2024-01-15, version 1.24.3 in 2025.", 0},
+		{"custom detector", &PIIScrubber{Detectors: []PIIDetector{
+			&PatternDetector{Kind: "ssn", Pattern: regexp.MustCompile(`\b\d{3}-\d{2}-\d{4}\b`)},
+		}}, "SSN 123-45-6789, mail a@b.io", "SSN [SSN], mail a@b.io", 1},
+		{"nothing", &PIIScrubber{}, "Plain text.", "Plain text.", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, n := tt.scrubber.Scrub(tt.text)
+			if got != tt.want || n != tt.wantCount {
+				t.Errorf("Scrub() = %q, %d, want %q, %d", got, n, tt.want, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestPIIScrubberOnResponses(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/search":
+			w.Write([]byte(`{"query": "q", "results": [
+				{"url": "https://a.example/", "content": "Contact sales@a.example", "raw_content": "Phone +44 20 7946 0958", "score": 0.9}
+			]}`))
+		case "/extract":
+			w.Write([]byte(`{"results": [{"url": "https://a.example/", "raw_content": "Write to sales@a.example"}]}`))
+		case "/crawl":
+			w.Write([]byte(`{"base_url": "https://a.example", "results": [{"url": "https://a.example/", "raw_content": "Write to sales@a.example"}]}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, PIIScrubber: &PIIScrubber{}})
+	ctx := context.Background()
+
+	search, err := client.Search(ctx, "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if r := search.Results[0]; r.Content != "Contact [EMAIL]" || r.RawContent != "Phone [PHONE]" {
+		t.Errorf("Search() result = %q / %q, want scrubbed content", r.Content, r.RawContent)
+	}
+	if search.Meta.PIIRedactions != 2 {
+		t.Errorf("Search() Meta.PIIRedactions = %v, want %v", search.Meta.PIIRedactions, 2)
+	}
+
+	type lean struct {
+		Content string `json:"content"`
+	}
+	into, _, err := SearchInto[lean](ctx, client, "q", nil)
+	if err != nil {
+		t.Fatalf("SearchInto() error = %v", err)
+	}
+	if into[0].Content != "Contact [EMAIL]" {
+		t.Errorf("SearchInto() content = %q, want scrubbed content", into[0].Content)
+	}
+
+	extract, err := client.Extract(ctx, []string{"https://a.example/"}, nil)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if got := extract.Results[0].RawContent; got != "Write to [EMAIL]" {
+		t.Errorf("Extract() raw content = %q, want scrubbed content", got)
+	}
+
+	crawl, err := client.Crawl(ctx, "https://a.example", &CrawlOptions{Preflight: PreflightOff})
+	if err != nil {
+		t.Fatalf("Crawl() error = %v", err)
+	}
+	if got := crawl.Results[0].RawContent; got != "Write to [EMAIL]" {
+		t.Errorf("Crawl() raw content = %q, want scrubbed content", got)
+	}
+}
+
+func TestPIIScrubberBeforeStorage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "answer": "Ask jane@a.example.", "results": [
+			{"url": "https://a.example/", "title": "jane@a.example's page", "content": "Plain.", "score": 0.9}
+		]}`))
+	}))
+	defer server.Close()
+
+	cache := &MemoryCache{}
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, PIIScrubber: &PIIScrubber{}, Cache: cache, KeepRawResponses: true})
+	resp, err := client.Search(context.Background(), "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if resp.Answer != "Ask [EMAIL]." || resp.Results[0].Title != "[EMAIL]'s page" {
+		t.Errorf("Search() answer = %q, title = %q, want them scrubbed", resp.Answer, resp.Results[0].Title)
+	}
+	if resp.Meta.PIIRedactions != 2 {
+		t.Errorf("Search() Meta.PIIRedactions = %v, want %v", resp.Meta.PIIRedactions, 2)
+	}
+	if strings.Contains(string(resp.Raw()), "jane@") {
+		t.Errorf("Raw() = %s, want it scrubbed", resp.Raw())
+	}
+	for key := range cache.entries {
+		if value, _ := cache.Get(key); strings.Contains(string(value), "jane@") {
+			t.Errorf("cached body = %s, want it scrubbed", value)
+		}
+	}
+}
+
+type panickingDetector struct{}
+
+func (panickingDetector) DetectPII(string) []PIIMatch { panic("detector exploded") }
+
+func TestPIIScrubberPanic(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": [{"url": "https://a.example/", "content": "sales@a.example", "score": 0.9}]}`))
+	}))
+	defer server.Close()
+
+	scrubber := &PIIScrubber{Detectors: []PIIDetector{panickingDetector{}}}
+
+	abort := New("tvly-test-key", &Options{BaseURL: server.URL, PIIScrubber: scrubber})
+	var perr *PanicError
+	if _, err := abort.Search(context.Background(), "q", nil); !errors.As(err, &perr) || perr.Hook != "PIIDetector" {
+		t.Errorf("Search() error = %v, want a PIIDetector *PanicError", err)
+	}
+
+	cont := New("tvly-test-key", &Options{BaseURL: server.URL, PIIScrubber: scrubber, PanicPolicy: PanicContinue})
+	result, err := cont.Search(context.Background(), "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := result.Results[0].Content; got != "" {
+		t.Errorf("Search() content = %q, want it cleared", got)
+	}
+}
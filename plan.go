@@ -0,0 +1,137 @@
+package tavily
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Feature names a capability a Tavily plan or API version may or may not include.
+type Feature string
+
+const (
+	FeatureAdvancedSearch  Feature = "advanced search"
+	FeatureAdvancedExtract Feature = "advanced extract"
+	FeatureCrawl           Feature = "crawl"
+	FeatureMap             Feature = "map"
+
+	// Request options, detected when set.
+	FeatureCountry           Feature = "country"
+	FeatureChunksPerSource   Feature = "chunks_per_source"
+	FeatureImageDescriptions Feature = "include_image_descriptions"
+	FeatureInstructions      Feature = "instructions"
+	FeatureCategories        Feature = "categories"
+)
+
+// Plan describes the limits of the Tavily plan behind an API key. Set
+// Options.Plan to have the client pace requests to the plan's rate limits,
+// default to its preferred depth and reject calls needing a feature the plan
+// lacks before they reach the API. The zero Plan imposes nothing.
+type Plan struct {
+	Name string
+	// RequestsPerMinute paces all requests when Options.Limiter is not set.
+	RequestsPerMinute int
+	// CrawlRequestsPerMinute additionally paces crawl requests.
+	CrawlRequestsPerMinute int
+	// SearchDepth is the default search depth.
+	SearchDepth SearchDepth
+	// ExtractDepth is the default extract and crawl depth.
+	ExtractDepth ExtractDepth
+	// Unsupported lists features calls fail fast on with a *PlanError.
+	Unsupported []Feature
+}
+
+// Presets for the rate limits Tavily documents for its API keys.
+var (
+	PlanDevelopment = Plan{Name: "development", RequestsPerMinute: 100, CrawlRequestsPerMinute: 100}
+	PlanProduction  = Plan{Name: "production", RequestsPerMinute: 1000, CrawlRequestsPerMinute: 100}
+)
+
+// PlanForKey guesses the plan from the API key: development keys start with
+// "tvly-dev-", anything else is treated as a production key.
+func PlanForKey(apiKey string) Plan {
+	if strings.HasPrefix(apiKey, "tvly-dev-") {
+		return PlanDevelopment
+	}
+	return PlanProduction
+}
+
+// Supports reports whether the plan includes feature.
+func (p Plan) Supports(feature Feature) bool {
+	return !slices.Contains(p.Unsupported, feature)
+}
+
+// PlanError reports a call needing a feature the configured plan lacks.
+type PlanError struct {
+	Plan    string
+	Feature Feature
+}
+
+func (e *PlanError) Error() string {
+	return fmt.Sprintf("%s is not available on the %s plan", e.Feature, e.Plan)
+}
+
+// requiredFeatures lists the features a request to endpoint relies on.
+func requiredFeatures(endpoint string, request any) []Feature {
+	var needed []Feature
+	switch req := request.(type) {
+	case *SearchRequest:
+		if req.SearchDepth == string(SearchDepthAdvanced) {
+			needed = append(needed, FeatureAdvancedSearch)
+		}
+		if req.Country != "" {
+			needed = append(needed, FeatureCountry)
+		}
+		if req.ChunksPerSource != 0 {
+			needed = append(needed, FeatureChunksPerSource)
+		}
+		if req.IncludeImageDescriptions != nil && *req.IncludeImageDescriptions {
+			needed = append(needed, FeatureImageDescriptions)
+		}
+	case *ExtractRequest:
+		if req.ExtractDepth == string(ExtractDepthAdvanced) {
+			needed = append(needed, FeatureAdvancedExtract)
+		}
+	case *CrawlRequest:
+		if req.Instructions != "" {
+			needed = append(needed, FeatureInstructions)
+		}
+		if len(req.Categories) > 0 {
+			needed = append(needed, FeatureCategories)
+		}
+	case *MapRequest:
+		if req.Instructions != "" {
+			needed = append(needed, FeatureInstructions)
+		}
+		if len(req.Categories) > 0 {
+			needed = append(needed, FeatureCategories)
+		}
+	}
+	switch endpoint {
+	case "/crawl":
+		needed = append(needed, FeatureCrawl)
+	case "/map":
+		needed = append(needed, FeatureMap)
+	}
+	return needed
+}
+
+// check rejects requests that need a feature p does not support.
+func (p Plan) check(endpoint string, request any) error {
+	for _, f := range requiredFeatures(endpoint, request) {
+		if !p.Supports(f) {
+			return &PlanError{Plan: p.Name, Feature: f}
+		}
+	}
+	return nil
+}
+
+// defaultSearchDepth returns the search depth used when a call does not choose one.
+func (p Plan) defaultSearchDepth() string {
+	return defaultString(string(p.SearchDepth), DefaultSearchDepth)
+}
+
+// defaultExtractDepth returns the extract depth used when a call does not choose one.
+func (p Plan) defaultExtractDepth() string {
+	return defaultString(string(p.ExtractDepth), DefaultExtractDepth)
+}
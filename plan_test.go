@@ -0,0 +1,110 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestPlanForKey(t *testing.T) {
+	tests := []struct {
+		key  string
+		want string
+	}{
+		{"tvly-dev-abc123", "development"},
+		{"tvly-prod-abc123", "production"},
+		{"tvly-abc123", "production"},
+	}
+
+	for _, tt := range tests {
+		if got := PlanForKey(tt.key); got.Name != tt.want {
+			t.Errorf("PlanForKey(%q) = %v, want %v", tt.key, got.Name, tt.want)
+		}
+	}
+}
+
+func TestPlanUnsupportedFeature(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Plan:    Plan{Name: "free", Unsupported: []Feature{FeatureAdvancedSearch, FeatureCrawl}},
+	})
+
+	_, err := client.Search(context.Background(), "q", &SearchOptions{SearchDepth: "advanced"})
+	var planErr *PlanError
+	if !errors.As(err, &planErr) || planErr.Feature != FeatureAdvancedSearch {
+		t.Fatalf("Search() error = %v, want *PlanError for %v", err, FeatureAdvancedSearch)
+	}
+
+	_, err = client.Crawl(context.Background(), "https://example.com", nil)
+	if !errors.As(err, &planErr) || planErr.Feature != FeatureCrawl {
+		t.Fatalf("Crawl() error = %v, want *PlanError for %v", err, FeatureCrawl)
+	}
+
+	if calls.Load() != 0 {
+		t.Errorf("API calls = %v, want none for unsupported features", calls.Load())
+	}
+
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Errorf("Search() basic error = %v", err)
+	}
+}
+
+func TestPlanDefaultDepth(t *testing.T) {
+	var body map[string]any
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		json.NewDecoder(r.Body).Decode(&body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Plan:    Plan{Name: "research", SearchDepth: SearchDepthAdvanced},
+	})
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if body["search_depth"] != "advanced" {
+		t.Errorf("search_depth = %v, want the plan default %v", body["search_depth"], "advanced")
+	}
+
+	// ChunksPerSource needs advanced depth, which the plan supplies.
+	if _, err := client.Search(context.Background(), "q", &SearchOptions{ChunksPerSource: 2}); err != nil {
+		t.Errorf("Search() with ChunksPerSource error = %v, want the plan's advanced depth to satisfy it", err)
+	}
+
+	if _, err := client.Extract(context.Background(), []string{"https://example.com"}, nil); err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if body["extract_depth"] != "basic" {
+		t.Errorf("extract_depth = %v, want %v as the plan only sets SearchDepth", body["extract_depth"], "basic")
+	}
+}
+
+func TestPlanLimitHint(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(432)
+		w.Write([]byte(`{"detail": {"error": "This request exceeds your plan's set usage limit."}}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-dev-key", &Options{BaseURL: server.URL, Plan: PlanForKey("tvly-dev-key")})
+	_, err := client.Search(context.Background(), "q", nil)
+	if err == nil || !strings.Contains(err.Error(), "development plan") {
+		t.Errorf("Search() error = %v, want it to name the development plan", err)
+	}
+}
@@ -0,0 +1,137 @@
+package tavily
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+)
+
+// PolitenessOptions limits how hard the client hits a single origin when many
+// Crawl, Map or Extract calls target the same host concurrently. The limits
+// also cover the client's own fetches of that host: link and health checks,
+// redirect resolution, robots.txt and local extraction.
+type PolitenessOptions struct {
+	// MaxConcurrentPerDomain caps in-flight requests per host. Zero means unlimited.
+	MaxConcurrentPerDomain int
+	// MinDelayPerDomain is the minimum spacing between request starts for the same host.
+	MinDelayPerDomain time.Duration
+}
+
+type domainSlot struct {
+	sem  chan struct{}
+	next time.Time
+}
+
+type domainLimiter struct {
+	opts  PolitenessOptions
+	clock Clock
+	mu    sync.Mutex
+	slots map[string]*domainSlot
+}
+
+func newDomainLimiter(opts *PolitenessOptions, clock Clock) *domainLimiter {
+	if opts == nil || (opts.MaxConcurrentPerDomain <= 0 && opts.MinDelayPerDomain <= 0) {
+		return nil
+	}
+	return &domainLimiter{
+		opts:  *opts,
+		clock: clockOr(clock),
+		slots: make(map[string]*domainSlot),
+	}
+}
+
+func (l *domainLimiter) slot(host string) *domainSlot {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	s, ok := l.slots[host]
+	if !ok {
+		s = &domainSlot{}
+		if l.opts.MaxConcurrentPerDomain > 0 {
+			s.sem = make(chan struct{}, l.opts.MaxConcurrentPerDomain)
+		}
+		l.slots[host] = s
+	}
+	return s
+}
+
+// acquire blocks until a request to every host in rawURLs may start.
+// The returned function releases the acquired slots and must always be called.
+func (l *domainLimiter) acquire(ctx context.Context, rawURLs ...string) (func(), error) {
+	if l == nil {
+		return func() {}, nil
+	}
+
+	hosts := make([]string, 0, len(rawURLs))
+	for _, raw := range rawURLs {
+		hosts = append(hosts, domainOf(raw))
+	}
+	// A stable order prevents two multi-host calls from deadlocking each other.
+	slices.Sort(hosts)
+	hosts = slices.Compact(hosts)
+
+	var held []*domainSlot
+	release := func() {
+		for _, s := range held {
+			if s.sem != nil {
+				<-s.sem
+			}
+		}
+	}
+
+	for _, host := range hosts {
+		s := l.slot(host)
+		if s.sem != nil {
+			select {
+			case s.sem <- struct{}{}:
+			case <-ctx.Done():
+				release()
+				return nil, ctx.Err()
+			}
+		}
+		held = append(held, s)
+
+		if err := l.wait(ctx, s); err != nil {
+			release()
+			return nil, err
+		}
+	}
+
+	return release, nil
+}
+
+func (l *domainLimiter) wait(ctx context.Context, s *domainSlot) error {
+	if l.opts.MinDelayPerDomain <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := l.clock.Now()
+	start := now
+	if s.next.After(now) {
+		start = s.next
+	}
+	s.next = start.Add(l.opts.MinDelayPerDomain)
+	l.mu.Unlock()
+
+	delay := start.Sub(now)
+	if delay <= 0 {
+		return nil
+	}
+	return l.clock.Sleep(ctx, delay)
+}
+
+func domainOf(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil || u.Host == "" {
+		// Bare hosts such as "example.com/docs" parse without a scheme.
+		u, err = url.Parse("https://" + rawURL)
+		if err != nil {
+			return strings.ToLower(rawURL)
+		}
+	}
+	return strings.ToLower(u.Hostname())
+}
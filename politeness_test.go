@@ -0,0 +1,117 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPolitenessLimitsConcurrency(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(20 * time.Millisecond)
+		inFlight.Add(-1)
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"response_time": 0.1, "base_url": "https://example.com", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL:    server.URL,
+		Politeness: &PolitenessOptions{MaxConcurrentPerDomain: 1},
+	})
+
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Map(ctx, "https://example.com/docs", nil); err != nil {
+				t.Errorf("Map() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if got := peak.Load(); got != 1 {
+		t.Errorf("peak concurrent requests = %v, want %v", got, 1)
+	}
+}
+
+func TestPolitenessDelay(t *testing.T) {
+	limiter := newDomainLimiter(&PolitenessOptions{MinDelayPerDomain: 30 * time.Millisecond}, nil)
+	ctx := context.Background()
+
+	start := time.Now()
+	for range 3 {
+		release, err := limiter.acquire(ctx, "https://example.com/a", "example.com/b")
+		if err != nil {
+			t.Fatalf("acquire() error = %v", err)
+		}
+		release()
+	}
+
+	if elapsed := time.Since(start); elapsed < 60*time.Millisecond {
+		t.Errorf("acquire() elapsed = %v, want at least %v", elapsed, 60*time.Millisecond)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	if _, err := limiter.acquire(cancelled, "https://example.com"); err == nil {
+		t.Error("Expected error for cancelled context, got nil")
+	}
+}
+
+func TestPolitenessLimitsDirectFetches(t *testing.T) {
+	var inFlight, peak atomic.Int32
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		for {
+			p := peak.Load()
+			if n <= p || peak.CompareAndSwap(p, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+		inFlight.Add(-1)
+
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<link rel="icon" href="/icon.png">`))
+	}))
+	defer site.Close()
+
+	client := New("tvly-test-key", &Options{
+		Politeness: &PolitenessOptions{MaxConcurrentPerDomain: 1},
+	})
+
+	results := make([]SearchResult, 4)
+	for i := range results {
+		results[i].URL = fmt.Sprintf("%s/page/%d", site.URL, i)
+	}
+	// Favicon lookups follow the HEAD with a GET to the same host.
+	client.EnrichResults(context.Background(), results, &EnrichOptions{Concurrency: 4, Favicon: true})
+
+	for _, r := range results {
+		if !r.Metadata.Reachable() || r.Metadata.FaviconURL != site.URL+"/icon.png" {
+			t.Errorf("Metadata(%s) = %+v, want reachable with favicon", r.URL, r.Metadata)
+		}
+	}
+	if got := peak.Load(); got != 1 {
+		t.Errorf("peak concurrent fetches = %v, want %v", got, 1)
+	}
+}
@@ -0,0 +1,217 @@
+package tavily
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+)
+
+// PreflightPolicy controls what Crawl does when the local pre-flight check finds a problem.
+type PreflightPolicy string
+
+const (
+	// PreflightOff skips the pre-flight check.
+	PreflightOff PreflightPolicy = ""
+	// PreflightWarn runs the check and records problems in CrawlResponse.PreflightWarnings.
+	PreflightWarn PreflightPolicy = "warn"
+	// PreflightFail runs the check and aborts the crawl with a *PreflightError on any problem.
+	PreflightFail PreflightPolicy = "fail"
+)
+
+// PreflightReport describes whether a crawl target looks crawlable from this machine.
+type PreflightReport struct {
+	URL        string
+	Reachable  bool
+	StatusCode int
+	Allowed    bool
+	Warnings   []string
+}
+
+// OK reports whether the report contains no warnings.
+func (r *PreflightReport) OK() bool {
+	return len(r.Warnings) == 0
+}
+
+// PreflightError is returned by Crawl when PreflightFail is set and the check found problems.
+type PreflightError struct {
+	Report *PreflightReport
+}
+
+func (e *PreflightError) Error() string {
+	return "preflight check failed: " + strings.Join(e.Report.Warnings, "; ")
+}
+
+// Preflight fetches robots.txt and issues a HEAD request to the seed URL locally,
+// reporting whether the target disallows crawling or is unreachable.
+// No Tavily credits are spent.
+func (c *Client) Preflight(ctx context.Context, seedURL string) (*PreflightReport, error) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer done()
+
+	u, err := url.Parse(seedURL)
+	if err != nil || u.Host == "" {
+		u, err = url.Parse("https://" + seedURL)
+		if err != nil {
+			return nil, fmt.Errorf("invalid URL %q: %w", seedURL, err)
+		}
+	}
+
+	report := &PreflightReport{URL: u.String(), Allowed: true}
+
+	status, err := c.preflightHead(ctx, u.String())
+	report.StatusCode = status
+	switch {
+	case err != nil:
+		report.Warnings = append(report.Warnings, fmt.Sprintf("seed URL unreachable: %v", err))
+	case status >= 400 && status != http.StatusMethodNotAllowed:
+		report.Warnings = append(report.Warnings, fmt.Sprintf("seed URL returned status %d", status))
+	default:
+		report.Reachable = true
+	}
+
+	robotsURL := &url.URL{Scheme: u.Scheme, Host: u.Host, Path: "/robots.txt"}
+	rules, err := c.fetchRobots(ctx, robotsURL.String())
+	if err != nil {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("robots.txt unavailable: %v", err))
+		return report, nil
+	}
+
+	path := u.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	if !rules.allows(path) {
+		report.Allowed = false
+		report.Warnings = append(report.Warnings, fmt.Sprintf("robots.txt disallows crawling %s", path))
+	}
+
+	return report, nil
+}
+
+func (c *Client) preflightHead(ctx context.Context, target string) (int, error) {
+	resp, err := c.probe(ctx, http.MethodHead, target)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, nil
+}
+
+func (c *Client) fetchRobots(ctx context.Context, target string) (*robotsRules, error) {
+	resp, err := c.probe(ctx, http.MethodGet, target)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusOK:
+		return parseRobots(io.LimitReader(resp.Body, 512*1024), ClientSource), nil
+	case resp.StatusCode >= 400 && resp.StatusCode < 500:
+		// A missing robots.txt means everything is allowed.
+		return &robotsRules{}, nil
+	default:
+		return nil, fmt.Errorf("status %d", resp.StatusCode)
+	}
+}
+
+type robotsRule struct {
+	allow   bool
+	length  int
+	pattern *regexp.Regexp
+}
+
+type robotsRules struct {
+	rules []robotsRule
+}
+
+// allows applies the longest-match rule, with Allow winning ties.
+func (r *robotsRules) allows(path string) bool {
+	best := -1
+	allowed := true
+	for _, rule := range r.rules {
+		if !rule.pattern.MatchString(path) {
+			continue
+		}
+		if rule.length > best || (rule.length == best && rule.allow) {
+			best = rule.length
+			allowed = rule.allow
+		}
+	}
+	return allowed
+}
+
+// parseRobots reads the group for agent, falling back to the "*" group.
+func parseRobots(r io.Reader, agent string) *robotsRules {
+	agent = strings.ToLower(agent)
+
+	var specific, wildcard []robotsRule
+	var groupAgents []string
+	inRules := false
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line, _, _ := strings.Cut(scanner.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		value = strings.TrimSpace(value)
+
+		switch key {
+		case "user-agent":
+			if inRules {
+				groupAgents = nil
+				inRules = false
+			}
+			groupAgents = append(groupAgents, strings.ToLower(value))
+		case "allow", "disallow":
+			inRules = true
+			if value == "" {
+				continue
+			}
+			rule := robotsRule{
+				allow:   key == "allow",
+				length:  len(value),
+				pattern: robotsPattern(value),
+			}
+			for _, a := range groupAgents {
+				switch {
+				case a == "*":
+					wildcard = append(wildcard, rule)
+				case strings.Contains(agent, a):
+					specific = append(specific, rule)
+				}
+			}
+		}
+	}
+
+	if len(specific) > 0 {
+		return &robotsRules{rules: specific}
+	}
+	return &robotsRules{rules: wildcard}
+}
+
+func robotsPattern(value string) *regexp.Regexp {
+	anchored := strings.HasSuffix(value, "$")
+	value = strings.TrimSuffix(value, "$")
+
+	parts := strings.Split(value, "*")
+	for i, p := range parts {
+		parts[i] = regexp.QuoteMeta(p)
+	}
+	pattern := "^" + strings.Join(parts, ".*")
+	if anchored {
+		pattern += "$"
+	}
+	return regexp.MustCompile(pattern)
+}
@@ -0,0 +1,68 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestParseRobots(t *testing.T) {
+	robots := `
+User-agent: *
+Disallow: /private/
+Allow: /private/public$
+
+User-agent: go-tavily
+Disallow: /docs/drafts
+`
+	rules := parseRobots(strings.NewReader(robots), ClientSource)
+	if rules.allows("/docs/drafts/v2") {
+		t.Error("Expected agent-specific group to disallow /docs/drafts/v2")
+	}
+	if !rules.allows("/private/") {
+		t.Error("Expected agent-specific group to replace the wildcard group")
+	}
+
+	rules = parseRobots(strings.NewReader(robots), "other-bot")
+	if rules.allows("/private/data") {
+		t.Error("Expected wildcard group to disallow /private/data")
+	}
+	if !rules.allows("/private/public") {
+		t.Error("Expected longer Allow rule to win")
+	}
+}
+
+func TestCrawlPreflightFail(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/robots.txt" {
+			w.Write([]byte("User-agent: *\nDisallow: /\n"))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer site.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("Expected crawl request to be skipped")
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: api.URL,
+	})
+
+	_, err := client.Crawl(context.Background(), site.URL+"/docs", &CrawlOptions{
+		Preflight: PreflightFail,
+	})
+
+	var preflightErr *PreflightError
+	if !errors.As(err, &preflightErr) {
+		t.Fatalf("Expected *PreflightError, got %T", err)
+	}
+	if preflightErr.Report.Allowed {
+		t.Error("Expected report to mark crawl as disallowed")
+	}
+}
@@ -0,0 +1,54 @@
+package tavily
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+)
+
+// Provenance traces a search result back to the retrieval call that produced
+// it, so generated claims can be attributed to an exact source and request.
+type Provenance struct {
+	// ResultID is stable for the same URL and content, see ResultID.
+	ResultID  string `json:"result_id"`
+	Query     string `json:"query"`
+	RequestID string `json:"request_id"`
+	// Rank is the 1-based position in the response of the originating call.
+	Rank        int       `json:"rank"`
+	Score       float64   `json:"score"`
+	RetrievedAt time.Time `json:"retrieved_at"`
+}
+
+// ResultID returns a stable identifier for r derived from its URL and content,
+// so the same snippet gets the same ID across calls.
+func ResultID(r SearchResult) string {
+	h := sha256.New()
+	h.Write([]byte(r.URL))
+	h.Write([]byte{0})
+	h.Write([]byte(r.Content))
+	return hex.EncodeToString(h.Sum(nil))[:16]
+}
+
+// resultIDOf returns the ID recorded in r's provenance, falling back to
+// ResultID for results that did not come from Search.
+func resultIDOf(r SearchResult) string {
+	if r.Provenance != nil && r.Provenance.ResultID != "" {
+		return r.Provenance.ResultID
+	}
+	return ResultID(r)
+}
+
+// attachProvenance records where every result of resp came from.
+func attachProvenance(resp *SearchResponse, query string) {
+	for i := range resp.Results {
+		r := &resp.Results[i]
+		r.Provenance = &Provenance{
+			ResultID:    ResultID(*r),
+			Query:       query,
+			RequestID:   resp.Meta.RequestID,
+			Rank:        i + 1,
+			Score:       r.Score,
+			RetrievedAt: resp.Meta.RetrievedAt,
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestSearchProvenance(t *testing.T) {
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://a.com", "content": "alpha", "score": 0.9},
+			{"url": "https://b.com", "content": "beta", "score": 0.8}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Clock:   tavilytest.NewFakeClock(now),
+	})
+
+	ctx := WithRequestID(context.Background(), "trace-1")
+	first, err := client.Search(ctx, "golang", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	second, err := client.Search(context.Background(), "golang", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+
+	p := first.Results[1].Provenance
+	if p == nil {
+		t.Fatal("Provenance = nil")
+	}
+	want := Provenance{
+		ResultID:    ResultID(first.Results[1]),
+		Query:       "golang",
+		RequestID:   "trace-1",
+		Rank:        2,
+		Score:       0.8,
+		RetrievedAt: now,
+	}
+	if *p != want {
+		t.Errorf("Provenance = %+v, want %+v", *p, want)
+	}
+	if p.ResultID != second.Results[1].Provenance.ResultID || p.ResultID == first.Results[0].Provenance.ResultID {
+		t.Errorf("ResultID not stable per URL and content: %s, %s, %s",
+			p.ResultID, second.Results[1].Provenance.ResultID, first.Results[0].Provenance.ResultID)
+	}
+}
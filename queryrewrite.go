@@ -0,0 +1,75 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// QueryRewriter rewrites search queries before they are sent, e.g. to tidy or
+// spell-correct input from a search box. Implementations must be safe for
+// concurrent use.
+type QueryRewriter interface {
+	Rewrite(ctx context.Context, query string) (string, error)
+}
+
+// QueryRewriterFunc adapts a function to QueryRewriter.
+type QueryRewriterFunc func(ctx context.Context, query string) (string, error)
+
+// Rewrite implements QueryRewriter.
+func (f QueryRewriterFunc) Rewrite(ctx context.Context, query string) (string, error) {
+	return f(ctx, query)
+}
+
+// QueryRewriters chains rewriters, feeding each the previous one's output.
+type QueryRewriters []QueryRewriter
+
+// Rewrite implements QueryRewriter.
+func (rs QueryRewriters) Rewrite(ctx context.Context, query string) (string, error) {
+	for _, r := range rs {
+		rewritten, err := r.Rewrite(ctx, query)
+		if err != nil {
+			return "", err
+		}
+		query = rewritten
+	}
+	return query, nil
+}
+
+// NormalizeQuery is a QueryRewriter trimming the query and collapsing runs of
+// whitespace, including newlines and tabs, into single spaces.
+var NormalizeQuery QueryRewriter = QueryRewriterFunc(func(_ context.Context, query string) (string, error) {
+	return strings.Join(strings.Fields(query), " "), nil
+})
+
+// QueryRewrite records a rewrite applied by the client's QueryRewriter.
+type QueryRewrite struct {
+	Original  string
+	Rewritten string
+}
+
+// rewriteQuery applies the client's QueryRewriter to query, returning the
+// query to send and the rewrite, if it changed anything. An empty rewrite
+// keeps the original query. Under PanicContinue a panicking rewriter is
+// skipped.
+func (c *Client) rewriteQuery(ctx context.Context, query string) (string, *QueryRewrite, error) {
+	if c.rewriter == nil {
+		return query, nil, nil
+	}
+	var rewritten string
+	var err error
+	if perr := c.runHook(ctx, "QueryRewriter", func() { rewritten, err = c.rewriter.Rewrite(ctx, query) }); perr != nil {
+		if c.abortOnPanic() {
+			return "", nil, perr
+		}
+		return query, nil, nil
+	}
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to rewrite query: %w", err)
+	}
+	if rewritten == "" || rewritten == query {
+		return query, nil, nil
+	}
+	c.logDebug(ctx, "tavily query rewritten", "original", query, "rewritten", rewritten)
+	return rewritten, &QueryRewrite{Original: query, Rewritten: rewritten}, nil
+}
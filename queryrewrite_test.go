@@ -0,0 +1,131 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestQueryRewriter(t *testing.T) {
+	var sent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.Query
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	errRewrite := errors.New("rewriter unavailable")
+	fixTypo := QueryRewriterFunc(func(_ context.Context, q string) (string, error) {
+		return strings.ReplaceAll(q, "golnag", "golang"), nil
+	})
+
+	tests := []struct {
+		name        string
+		rewriter    QueryRewriter
+		policy      PanicPolicy
+		query       string
+		wantSent    string
+		wantRewrite *QueryRewrite
+		wantErr     error
+	}{
+		{
+			name:        "normalize",
+			rewriter:    NormalizeQuery,
+			query:       "  golang\n generics\t",
+			wantSent:    "golang generics",
+			wantRewrite: &QueryRewrite{Original: "  golang\n generics\t", Rewritten: "golang generics"},
+		},
+		{
+			name:     "unchanged",
+			rewriter: NormalizeQuery,
+			query:    "golang generics",
+			wantSent: "golang generics",
+		},
+		{
+			name:        "chain",
+			rewriter:    QueryRewriters{NormalizeQuery, fixTypo},
+			query:       " golnag  generics",
+			wantSent:    "golang generics",
+			wantRewrite: &QueryRewrite{Original: " golnag  generics", Rewritten: "golang generics"},
+		},
+		{
+			name: "empty rewrite keeps query",
+			rewriter: QueryRewriterFunc(func(context.Context, string) (string, error) {
+				return "", nil
+			}),
+			query:    "golang",
+			wantSent: "golang",
+		},
+		{
+			name: "error",
+			rewriter: QueryRewriterFunc(func(context.Context, string) (string, error) {
+				return "", errRewrite
+			}),
+			query:   "golang",
+			wantErr: errRewrite,
+		},
+		{
+			name: "panic continues",
+			rewriter: QueryRewriterFunc(func(context.Context, string) (string, error) {
+				panic("rewriter exploded")
+			}),
+			policy:   PanicContinue,
+			query:    "golang",
+			wantSent: "golang",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent = ""
+			client := New("tvly-test-key", &Options{
+				BaseURL:       server.URL,
+				QueryRewriter: tt.rewriter,
+				PanicPolicy:   tt.policy,
+			})
+
+			result, err := client.Search(context.Background(), tt.query, nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Errorf("Search() error = %v, want %v", err, tt.wantErr)
+				}
+				if sent != "" {
+					t.Errorf("Search() sent %q after a failed rewrite", sent)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if sent != tt.wantSent {
+				t.Errorf("Search() sent query = %q, want %q", sent, tt.wantSent)
+			}
+			got := result.Meta.QueryRewrite
+			if (got == nil) != (tt.wantRewrite == nil) || (got != nil && *got != *tt.wantRewrite) {
+				t.Errorf("Meta.QueryRewrite = %+v, want %+v", got, tt.wantRewrite)
+			}
+		})
+	}
+}
+
+func TestQueryRewriterPanicAbort(t *testing.T) {
+	client := New("tvly-test-key", &Options{
+		BaseURL: "http://127.0.0.1:1",
+		QueryRewriter: QueryRewriterFunc(func(context.Context, string) (string, error) {
+			panic("rewriter exploded")
+		}),
+	})
+
+	_, err := client.Search(context.Background(), "golang", nil)
+	var perr *PanicError
+	if !errors.As(err, &perr) || perr.Hook != "QueryRewriter" {
+		t.Errorf("Search() error = %v, want a QueryRewriter *PanicError", err)
+	}
+}
@@ -0,0 +1,158 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// ErrQuotaExceeded is matched by *QuotaError.
+var ErrQuotaExceeded = errors.New("tavily: tenant quota exceeded")
+
+type tenantKey struct{}
+
+// WithTenant returns a context whose Tavily calls are accounted to tenant by the
+// client's QuotaManager.
+func WithTenant(ctx context.Context, tenant string) context.Context {
+	return context.WithValue(ctx, tenantKey{}, tenant)
+}
+
+// TenantFromContext returns the tenant stored by WithTenant.
+func TenantFromContext(ctx context.Context) (string, bool) {
+	tenant, ok := ctx.Value(tenantKey{}).(string)
+	return tenant, ok
+}
+
+// QuotaLimit bounds a tenant's usage per window. Zero fields are unlimited.
+type QuotaLimit struct {
+	MaxRequests int
+	MaxCredits  float64
+}
+
+// TenantUsage is a tenant's usage in the current window.
+type TenantUsage struct {
+	Requests int
+	Credits  float64
+	// Since is when the current window started.
+	Since time.Time
+}
+
+// QuotaError is returned when a call would exceed its tenant's quota.
+type QuotaError struct {
+	Tenant string
+	Limit  QuotaLimit
+	Usage  TenantUsage
+}
+
+func (e *QuotaError) Error() string {
+	return fmt.Sprintf("tenant %q quota exceeded: %d requests, %.1f credits used (limit %d requests, %.1f credits)",
+		e.Tenant, e.Usage.Requests, e.Usage.Credits, e.Limit.MaxRequests, e.Limit.MaxCredits)
+}
+
+// Is makes errors.Is(err, ErrQuotaExceeded) match.
+func (e *QuotaError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// QuotaManager partitions request counts and estimated credits across tenants
+// set with WithTenant, rejecting calls from tenants that used up their limit.
+// Calls without a tenant are accounted to the empty tenant. Cache hits are free.
+// The zero value tracks usage without limits and is safe for concurrent use.
+//
+// Credits are only known once a response arrives, so a tenant may overshoot
+// MaxCredits by the cost of its in-flight calls.
+type QuotaManager struct {
+	// DefaultLimit applies to tenants without a limit set by SetLimit.
+	DefaultLimit QuotaLimit
+	// Window is the accounting period after which usage resets. Zero never resets.
+	Window time.Duration
+	// Clock decides when windows reset. Nil uses SystemClock.
+	Clock Clock
+
+	mu     sync.Mutex
+	limits map[string]QuotaLimit
+	usage  map[string]*TenantUsage
+}
+
+// SetLimit sets the quota of tenant.
+func (q *QuotaManager) SetLimit(tenant string, limit QuotaLimit) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if q.limits == nil {
+		q.limits = make(map[string]QuotaLimit)
+	}
+	q.limits[tenant] = limit
+}
+
+// Usage returns tenant's usage in the current window.
+func (q *QuotaManager) Usage(tenant string) TenantUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if _, ok := q.usage[tenant]; !ok {
+		return TenantUsage{}
+	}
+	return *q.current(tenant)
+}
+
+// AllUsage returns the current usage of every tenant seen so far.
+func (q *QuotaManager) AllUsage() map[string]TenantUsage {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	all := make(map[string]TenantUsage, len(q.usage))
+	for tenant := range q.usage {
+		all[tenant] = *q.current(tenant)
+	}
+	return all
+}
+
+// Reset clears tenant's usage and starts a new window.
+func (q *QuotaManager) Reset(tenant string) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	delete(q.usage, tenant)
+}
+
+// reserve admits a request for the tenant in ctx, counting it immediately so
+// concurrent calls cannot all slip under MaxRequests.
+func (q *QuotaManager) reserve(ctx context.Context) (string, error) {
+	tenant, _ := TenantFromContext(ctx)
+
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	limit, ok := q.limits[tenant]
+	if !ok {
+		limit = q.DefaultLimit
+	}
+	usage := q.current(tenant)
+	if (limit.MaxRequests > 0 && usage.Requests >= limit.MaxRequests) ||
+		(limit.MaxCredits > 0 && usage.Credits >= limit.MaxCredits) {
+		return tenant, &QuotaError{Tenant: tenant, Limit: limit, Usage: *usage}
+	}
+	usage.Requests++
+	return tenant, nil
+}
+
+// record adds the credits spent by a completed request.
+func (q *QuotaManager) record(tenant string, credits float64) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	q.current(tenant).Credits += credits
+}
+
+// current returns tenant's usage, starting a new window if the last one
+// expired. q.mu must be held.
+func (q *QuotaManager) current(tenant string) *TenantUsage {
+	now := clockOr(q.Clock).Now()
+	usage, ok := q.usage[tenant]
+	if !ok || (q.Window > 0 && now.Sub(usage.Since) >= q.Window) {
+		if q.usage == nil {
+			q.usage = make(map[string]*TenantUsage)
+		}
+		usage = &TenantUsage{Since: now}
+		q.usage[tenant] = usage
+	}
+	return usage
+}
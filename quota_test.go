@@ -0,0 +1,68 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestQuotaManager(t *testing.T) {
+	clock := tavilytest.NewFakeClock(time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC))
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": []}`))
+	}))
+	defer server.Close()
+
+	quota := &QuotaManager{
+		DefaultLimit: QuotaLimit{MaxRequests: 2},
+		Window:       time.Hour,
+		Clock:        clock,
+	}
+	quota.SetLimit("premium", QuotaLimit{MaxCredits: 4})
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Quota:   quota,
+	})
+	acme := WithTenant(context.Background(), "acme")
+	premium := WithTenant(context.Background(), "premium")
+
+	for range 2 {
+		if _, err := client.Search(acme, "test", nil); err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+	}
+	_, err := client.Search(acme, "test", nil)
+	var quotaErr *QuotaError
+	if !errors.As(err, &quotaErr) || !errors.Is(err, ErrQuotaExceeded) || quotaErr.Tenant != "acme" {
+		t.Errorf("Search() over quota error = %v, want *QuotaError for acme", err)
+	}
+
+	for range 2 {
+		if _, err := client.Search(premium, "test", &SearchOptions{SearchDepth: "advanced"}); err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+	}
+	if _, err := client.Search(premium, "test", nil); !errors.Is(err, ErrQuotaExceeded) {
+		t.Errorf("Search() over credit quota error = %v, want %v", err, ErrQuotaExceeded)
+	}
+	if got := quota.Usage("premium"); got.Requests != 2 || got.Credits != 4 {
+		t.Errorf("Usage(premium) = %+v, want 2 requests and 4 credits", got)
+	}
+
+	clock.Advance(time.Hour)
+	if _, err := client.Search(acme, "test", nil); err != nil {
+		t.Errorf("Search() after window reset error = %v", err)
+	}
+	if usage := quota.AllUsage(); len(usage) != 2 || usage["acme"].Requests != 1 {
+		t.Errorf("AllUsage() = %+v, want acme reset to 1 request", usage)
+	}
+}
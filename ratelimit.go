@@ -0,0 +1,52 @@
+package tavily
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Limiter paces requests sent to the Tavily API. Share one Limiter between
+// every Client using the same API key, e.g. clients created per tenant, so
+// their aggregate rate stays within the plan's limit. Every HTTP attempt,
+// including retries, takes a slot; cache hits do not.
+// The zero value does not limit and is safe for concurrent use.
+type Limiter struct {
+	// RequestsPerMinute caps the aggregate request rate. Zero means unlimited.
+	RequestsPerMinute int
+	// Burst is how many requests may start at once after a quiet period.
+	// Defaults to 1, which spaces requests evenly.
+	Burst int
+	// Clock paces the requests. Nil uses SystemClock.
+	Clock Clock
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Wait blocks until a request may start or ctx is done.
+func (l *Limiter) Wait(ctx context.Context) error {
+	if l == nil || l.RequestsPerMinute <= 0 {
+		return ctx.Err()
+	}
+	interval := time.Minute / time.Duration(l.RequestsPerMinute)
+	tolerance := time.Duration(max(l.Burst, 1)-1) * interval
+
+	clock := clockOr(l.Clock)
+	l.mu.Lock()
+	now := clock.Now()
+	// next is the theoretical start of the following request if requests had
+	// been evenly spaced; up to Burst requests may start ahead of it.
+	due := l.next
+	if due.Before(now) {
+		due = now
+	}
+	start := due.Add(-tolerance)
+	if start.Before(now) {
+		start = now
+	}
+	l.next = due.Add(interval)
+	l.mu.Unlock()
+
+	return clock.Sleep(ctx, start.Sub(now))
+}
@@ -0,0 +1,72 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestLimiterSharedAcrossClients(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	limiter := &Limiter{RequestsPerMinute: 1200} // one request every 50ms
+	tenants := []*Client{
+		New("tvly-test-key", &Options{BaseURL: server.URL, Limiter: limiter}),
+		New("tvly-test-key", &Options{BaseURL: server.URL, Limiter: limiter}),
+	}
+
+	start := time.Now()
+	var wg sync.WaitGroup
+	for i := range 4 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := tenants[i%2].Search(context.Background(), "q", nil); err != nil {
+				t.Errorf("Search() error = %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 150*time.Millisecond {
+		t.Errorf("4 requests took %v, want at least 150ms at 20 requests per second", elapsed)
+	}
+}
+
+func TestLimiterBurst(t *testing.T) {
+	limiter := &Limiter{RequestsPerMinute: 60, Burst: 3}
+
+	start := time.Now()
+	for range 3 {
+		if err := limiter.Wait(context.Background()); err != nil {
+			t.Fatalf("Wait() error = %v", err)
+		}
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Errorf("burst of 3 took %v, want no waiting", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait() past the burst error = %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestLimiterZeroValue(t *testing.T) {
+	var limiter *Limiter
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Errorf("nil Wait() error = %v", err)
+	}
+	if err := (&Limiter{}).Wait(context.Background()); err != nil {
+		t.Errorf("zero Wait() error = %v", err)
+	}
+}
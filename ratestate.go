@@ -0,0 +1,79 @@
+package tavily
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// RateLimitState is the rate limit reported by the most recent API response.
+type RateLimitState struct {
+	// Limit is the number of requests allowed per window.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the window resets. Zero if the API did not say.
+	Reset time.Time
+	// UpdatedAt is when the state was read. Zero if no response carried
+	// rate limit headers yet, in which case the other fields are meaningless.
+	UpdatedAt time.Time
+}
+
+// Known reports whether any response has carried rate limit headers.
+func (s RateLimitState) Known() bool {
+	return !s.UpdatedAt.IsZero()
+}
+
+// rateLimitTracker keeps the latest RateLimitState of a client.
+type rateLimitTracker struct {
+	clock Clock
+	mu    sync.Mutex
+	state RateLimitState
+}
+
+// observe records the rate limit headers of resp, if any. Both the common
+// X-RateLimit-* and the standard RateLimit-* names are understood.
+func (t *rateLimitTracker) observe(resp *http.Response) {
+	limit, okLimit := headerInt(resp.Header, "X-RateLimit-Limit", "RateLimit-Limit")
+	remaining, okRemaining := headerInt(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if !okLimit && !okRemaining {
+		return
+	}
+
+	now := t.clock.Now()
+	state := RateLimitState{Limit: limit, Remaining: remaining, UpdatedAt: now}
+	if reset, ok := headerInt(resp.Header, "X-RateLimit-Reset", "RateLimit-Reset"); ok {
+		// Large values are Unix timestamps, small ones seconds from now.
+		if reset > 1_000_000_000 {
+			state.Reset = time.Unix(int64(reset), 0)
+		} else {
+			state.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	t.mu.Lock()
+	t.state = state
+	t.mu.Unlock()
+}
+
+func (t *rateLimitTracker) get() RateLimitState {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.state
+}
+
+func headerInt(h http.Header, names ...string) (int, bool) {
+	for _, name := range names {
+		if v, err := strconv.Atoi(h.Get(name)); err == nil && v >= 0 {
+			return v, true
+		}
+	}
+	return 0, false
+}
+
+// RateLimitState returns the rate limit reported by the most recent API
+// response, so callers can slow down before hitting 429s.
+func (c *Client) RateLimitState() RateLimitState {
+	return c.rateLimits.get()
+}
@@ -0,0 +1,65 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestRateLimitState(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	headers := map[string]string{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		for k, v := range headers {
+			w.Header().Set(k, v)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: tavilytest.NewFakeClock(now)})
+	if client.RateLimitState().Known() {
+		t.Fatalf("RateLimitState() known before any response")
+	}
+
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    RateLimitState
+	}{
+		{
+			name:    "relative reset",
+			headers: map[string]string{"X-RateLimit-Limit": "100", "X-RateLimit-Remaining": "42", "X-RateLimit-Reset": "30"},
+			want:    RateLimitState{Limit: 100, Remaining: 42, Reset: now.Add(30 * time.Second), UpdatedAt: now},
+		},
+		{
+			name:    "standard headers with epoch reset",
+			headers: map[string]string{"RateLimit-Limit": "1000", "RateLimit-Remaining": "7", "RateLimit-Reset": "1772366460"},
+			want:    RateLimitState{Limit: 1000, Remaining: 7, Reset: time.Unix(1772366460, 0), UpdatedAt: now},
+		},
+		{
+			name:    "no headers keeps the last state",
+			headers: map[string]string{},
+			want:    RateLimitState{Limit: 1000, Remaining: 7, Reset: time.Unix(1772366460, 0), UpdatedAt: now},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			headers = tt.headers
+			if _, err := client.Search(context.Background(), "q", nil); err != nil {
+				t.Fatalf("Search() error = %v", err)
+			}
+			if got := client.RateLimitState(); !got.Reset.Equal(tt.want.Reset) || got.Limit != tt.want.Limit ||
+				got.Remaining != tt.want.Remaining || !got.UpdatedAt.Equal(tt.want.UpdatedAt) {
+				t.Errorf("RateLimitState() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+package tavily
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+// FormatPolicy controls what happens when the API returns raw content in a
+// different format than requested.
+type FormatPolicy string
+
+const (
+	// FormatPolicyIgnore returns raw content as received.
+	FormatPolicyIgnore FormatPolicy = ""
+	// FormatPolicyConvert converts mismatched raw content locally to the requested format.
+	FormatPolicyConvert FormatPolicy = "convert"
+	// FormatPolicyError fails the call with a *CapabilityError.
+	FormatPolicyError FormatPolicy = "error"
+)
+
+// formatHTML marks raw content that arrived as HTML markup.
+const formatHTML Format = "html"
+
+// CapabilityError reports that the API did not honor a requested feature,
+// typically because the account or endpoint does not support it.
+type CapabilityError struct {
+	Feature   string
+	Requested Format
+	Received  Format
+}
+
+func (e *CapabilityError) Error() string {
+	return fmt.Sprintf("%s: requested %s but received %s", e.Feature, e.Requested, e.Received)
+}
+
+var (
+	markdownSignals = regexp.MustCompile(`(?m)^#{1,6}\s|\*\*[^*\n]+\*\*|\[[^\]\n]+\]\([^)\s]+\)|^\s*[-*+]\s+\S|^\x60{3}`)
+	htmlSignals     = regexp.MustCompile(`(?i)<(?:p|div|span|a|h[1-6]|ul|ol|li|br|table|html|body)\b[^>]*>`)
+)
+
+// DetectFormat guesses whether content is HTML, markdown or plain text.
+func DetectFormat(content string) Format {
+	switch {
+	case len(htmlSignals.FindAllStringIndex(content, 3)) >= 3:
+		return formatHTML
+	case markdownSignals.MatchString(content):
+		return FormatMarkdown
+	default:
+		return FormatText
+	}
+}
+
+// requestedRawFormat maps SearchOptions.IncludeRawContent to a format, or "" when
+// raw content was not requested. A bare true asks for markdown.
+func requestedRawFormat(v any) Format {
+	switch v := v.(type) {
+	case bool:
+		if v {
+			return FormatMarkdown
+		}
+	case string:
+		return Format(strings.ToLower(v))
+	case Format:
+		return v
+	}
+	return ""
+}
+
+// reconcileRawContent applies policy to results whose raw content does not match
+// the requested format. Plain text satisfies a markdown request only when some
+// result in the response does carry markdown; otherwise the API silently
+// downgraded the request.
+func reconcileRawContent(results []SearchResult, requested Format, policy FormatPolicy) error {
+	if policy == FormatPolicyIgnore || (requested != FormatText && requested != FormatMarkdown) {
+		return nil
+	}
+
+	detected := make([]Format, len(results))
+	anyMarkdown := false
+	for i, r := range results {
+		if r.RawContent == "" {
+			continue
+		}
+		detected[i] = DetectFormat(r.RawContent)
+		anyMarkdown = anyMarkdown || detected[i] == FormatMarkdown
+	}
+
+	for i := range results {
+		received := detected[i]
+		mismatch := received == formatHTML ||
+			(requested == FormatText && received == FormatMarkdown) ||
+			(requested == FormatMarkdown && received == FormatText && !anyMarkdown)
+		if received == "" || !mismatch {
+			continue
+		}
+
+		if policy == FormatPolicyError {
+			return &CapabilityError{Feature: "include_raw_content", Requested: requested, Received: received}
+		}
+		results[i].RawContent = convertContent(results[i].RawContent, received, requested)
+	}
+	return nil
+}
+
+var (
+	mdHeading   = regexp.MustCompile(`(?m)^#{1,6}\s+`)
+	mdEmphasis  = regexp.MustCompile(`(\*\*|__|\*|_)([^*_\n]+)(\*\*|__|\*|_)`)
+	mdLink      = regexp.MustCompile(`!?\[([^\]\n]*)\]\([^)\s]+\)`)
+	mdListItem  = regexp.MustCompile(`(?m)^(\s*)[-*+]\s+`)
+	mdFence     = regexp.MustCompile("(?m)^\x60{3}.*$\n?")
+	htmlHeading = regexp.MustCompile(`(?is)<h([1-6])\b[^>]*>(.*?)</h[1-6]\s*>`)
+	htmlLink    = regexp.MustCompile(`(?is)<a\b[^>]*\bhref\s*=\s*["']([^"']+)["'][^>]*>(.*?)</a\s*>`)
+	htmlStrong  = regexp.MustCompile(`(?is)<(?:strong|b)\b[^>]*>(.*?)</(?:strong|b)\s*>`)
+	htmlItem    = regexp.MustCompile(`(?is)<li\b[^>]*>`)
+	textLeading = regexp.MustCompile(`(?m)^([#>*+-]|\d+\.)(\s)`)
+)
+
+func convertContent(content string, from, to Format) string {
+	switch {
+	case from == formatHTML && to == FormatMarkdown:
+		content = htmlHeading.ReplaceAllStringFunc(content, func(h string) string {
+			m := htmlHeading.FindStringSubmatch(h)
+			return "\n" + strings.Repeat("#", int(m[1][0]-'0')) + " " + m[2] + "\n"
+		})
+		content = htmlLink.ReplaceAllString(content, "[$2]($1)")
+		content = htmlStrong.ReplaceAllString(content, "**$1**")
+		content = htmlItem.ReplaceAllString(content, "\n- ")
+		return stripHTML(content)
+	case from == formatHTML:
+		return stripHTML(content)
+	case from == FormatMarkdown && to == FormatText:
+		content = mdFence.ReplaceAllString(content, "")
+		content = mdLink.ReplaceAllString(content, "$1")
+		content = mdHeading.ReplaceAllString(content, "")
+		content = mdEmphasis.ReplaceAllString(content, "$2")
+		return mdListItem.ReplaceAllString(content, "$1")
+	case from == FormatText && to == FormatMarkdown:
+		// Escape line starts that markdown would misread as syntax.
+		return textLeading.ReplaceAllString(content, `\$1$2`)
+	}
+	return content
+}
+
+func stripHTML(content string) string {
+	content = blockBoundary.ReplaceAllString(content, "\n")
+	content = anyTag.ReplaceAllString(content, "")
+	content = html.UnescapeString(content)
+
+	var lines []string
+	for line := range strings.SplitSeq(content, "\n") {
+		if line = strings.TrimSpace(inlineSpace.ReplaceAllString(line, " ")); line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return strings.Join(lines, "\n\n")
+}
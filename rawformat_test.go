@@ -0,0 +1,61 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		content string
+		want    Format
+	}{
+		{content: "Plain sentence.\n\nAnother one.", want: FormatText},
+		{content: "# Title\n\nSome **bold** text.", want: FormatMarkdown},
+		{content: "<div><p>One</p><p>Two</p></div>", want: formatHTML},
+	}
+	for _, tt := range tests {
+		if got := DetectFormat(tt.content); got != tt.want {
+			t.Errorf("DetectFormat(%q) = %v, want %v", tt.content, got, tt.want)
+		}
+	}
+}
+
+func TestRawContentPolicy(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "results": [
+			{"url": "https://example.com", "score": 0.9, "raw_content": "# Heading\n\nSee [docs](https://example.com/docs) for **details**."}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+	})
+	ctx := context.Background()
+
+	result, err := client.Search(ctx, "test", &SearchOptions{
+		IncludeRawContent: string(FormatText),
+		RawContentPolicy:  FormatPolicyConvert,
+	})
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got, want := result.Results[0].RawContent, "Heading\n\nSee docs for details."; got != want {
+		t.Errorf("converted RawContent = %q, want %q", got, want)
+	}
+
+	_, err = client.Search(ctx, "test", &SearchOptions{
+		IncludeRawContent: string(FormatText),
+		RawContentPolicy:  FormatPolicyError,
+	})
+	var capErr *CapabilityError
+	if !errors.As(err, &capErr) || capErr.Received != FormatMarkdown {
+		t.Errorf("Search() error = %v, want *CapabilityError for markdown", err)
+	}
+}
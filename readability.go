@@ -0,0 +1,158 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// maxFallbackBody bounds how much of a page is downloaded for local extraction.
+const maxFallbackBody = 5 * 1024 * 1024
+
+// ContentExtractor turns a locally fetched document into readable text.
+// It is used as a fallback for URLs the API failed to extract.
+type ContentExtractor interface {
+	ExtractContent(ctx context.Context, pageURL, contentType string, body []byte) (string, error)
+}
+
+// BasicExtractor is a dependency-free, readability-style ContentExtractor. It keeps
+// the <article> or <main> element when present, drops scripts, styles and page
+// chrome, and returns the remaining text with paragraph breaks.
+type BasicExtractor struct{}
+
+var (
+	droppedElements = regexp.MustCompile(`(?is)<(script|style|noscript|template|svg|nav|header|footer|aside|form)\b.*?</(?:script|style|noscript|template|svg|nav|header|footer|aside|form)\s*>`)
+	mainElement     = regexp.MustCompile(`(?is)<(article|main)\b[^>]*>(.*?)</(?:article|main)\s*>`)
+	bodyElement     = regexp.MustCompile(`(?is)<body\b[^>]*>(.*)</body\s*>`)
+	blockBoundary   = regexp.MustCompile(`(?i)<(?:br\s*/?|/p|/div|/h[1-6]|/li|/tr|/blockquote|/pre|/section)\s*>`)
+	htmlComment     = regexp.MustCompile(`(?s)<!--.*?-->`)
+	anyTag          = regexp.MustCompile(`(?s)<[^>]*>`)
+	inlineSpace     = regexp.MustCompile(`[ \t\f\v\r]+`)
+)
+
+// ExtractContent implements ContentExtractor.
+func (BasicExtractor) ExtractContent(_ context.Context, _ string, contentType string, body []byte) (string, error) {
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "text/html" || mediaType == "application/xhtml+xml" || mediaType == "":
+	case strings.HasPrefix(mediaType, "text/"):
+		return strings.TrimSpace(string(body)), nil
+	default:
+		return "", fmt.Errorf("unsupported content type %q", mediaType)
+	}
+
+	doc := htmlComment.ReplaceAllString(string(body), "")
+	doc = droppedElements.ReplaceAllString(doc, "")
+	if m := mainElement.FindStringSubmatch(doc); m != nil {
+		doc = m[2]
+	} else if m := bodyElement.FindStringSubmatch(doc); m != nil {
+		doc = m[1]
+	}
+
+	// Source line breaks are insignificant in HTML; only block boundaries split paragraphs.
+	doc = strings.ReplaceAll(doc, "\n", " ")
+	doc = blockBoundary.ReplaceAllString(doc, "\n")
+	doc = anyTag.ReplaceAllString(doc, " ")
+	doc = html.UnescapeString(doc)
+
+	var paragraphs []string
+	for line := range strings.SplitSeq(doc, "\n") {
+		if line = strings.TrimSpace(inlineSpace.ReplaceAllString(line, " ")); line != "" {
+			paragraphs = append(paragraphs, line)
+		}
+	}
+	if len(paragraphs) == 0 {
+		return "", fmt.Errorf("no readable content")
+	}
+	return strings.Join(paragraphs, "\n\n"), nil
+}
+
+// extractLocally retries the API's failed URLs with the local extractor. URLs it
+// recovers move from FailedResults to Results with LocalFallback set.
+func (c *Client) extractLocally(ctx context.Context, resp *ExtractResponse, extractor ContentExtractor) error {
+	if len(resp.FailedResults) == 0 {
+		return nil
+	}
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer done()
+
+	recovered := make([]*ExtractResult, len(resp.FailedResults))
+	panics := make([]error, len(resp.FailedResults))
+	sem := make(chan struct{}, DefaultEnrichConcurrency)
+	var wg sync.WaitGroup
+	for i, failed := range resp.FailedResults {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			content, err := c.fetchAndExtract(ctx, failed.URL, extractor)
+			var perr *PanicError
+			if errors.As(err, &perr) && c.abortOnPanic() {
+				panics[i] = err
+				return
+			}
+			if err != nil {
+				c.logDebug(ctx, "tavily local extraction failed", "url", failed.URL, "error", err)
+				return
+			}
+			recovered[i] = &ExtractResult{URL: failed.URL, RawContent: content, LocalFallback: true}
+		}()
+	}
+	wg.Wait()
+	for _, err := range panics {
+		if err != nil {
+			return err
+		}
+	}
+
+	stillFailed := resp.FailedResults[:0]
+	for i, failed := range resp.FailedResults {
+		if recovered[i] != nil {
+			resp.Results = append(resp.Results, *recovered[i])
+		} else {
+			stillFailed = append(stillFailed, failed)
+		}
+	}
+	resp.FailedResults = stillFailed
+	return nil
+}
+
+func (c *Client) fetchAndExtract(ctx context.Context, pageURL string, extractor ContentExtractor) (string, error) {
+	resp, err := c.probe(ctx, http.MethodGet, pageURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxFallbackBody))
+	if err != nil {
+		return "", err
+	}
+	var content string
+	if perr := c.runHook(ctx, "ContentExtractor", func() {
+		content, err = extractor.ExtractContent(ctx, resp.Request.URL.String(), resp.Header.Get("Content-Type"), body)
+	}); perr != nil {
+		return "", perr
+	}
+	return content, err
+}
@@ -0,0 +1,72 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestBasicExtractor(t *testing.T) {
+	page := `<html><head><title>T</title><style>p{}</style></head><body>
+<nav>Home | About</nav>
+<article><h1>Title</h1><p>First &amp; foremost.</p><script>track()</script><p>Second
+   paragraph.</p></article>
+<footer>Copyright</footer></body></html>`
+
+	got, err := BasicExtractor{}.ExtractContent(context.Background(), "", "text/html; charset=utf-8", []byte(page))
+	if err != nil {
+		t.Fatalf("ExtractContent() error = %v", err)
+	}
+	want := "Title\n\nFirst & foremost.\n\nSecond paragraph."
+	if got != want {
+		t.Errorf("ExtractContent() = %q, want %q", got, want)
+	}
+
+	if _, err := (BasicExtractor{}).ExtractContent(context.Background(), "", "image/png", nil); err == nil {
+		t.Error("Expected error for binary content, got nil")
+	}
+}
+
+func TestExtractLocalFallback(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/ok" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "text/html")
+		w.Write([]byte(`<body><main><p>Recovered content</p></main></body>`))
+	}))
+	defer site.Close()
+
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"results": [], "failed_results": [
+			{"url": "` + site.URL + `/ok", "error": "blocked"},
+			{"url": "` + site.URL + `/missing", "error": "blocked"}
+		]}`))
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: api.URL,
+	})
+
+	result, err := client.Extract(context.Background(), []string{site.URL + "/ok", site.URL + "/missing"}, &ExtractOptions{
+		LocalFallback: BasicExtractor{},
+	})
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if len(result.Results) != 1 || !result.Results[0].LocalFallback {
+		t.Fatalf("Extract() results = %+v", result.Results)
+	}
+	if !strings.Contains(result.Results[0].RawContent, "Recovered content") {
+		t.Errorf("RawContent = %q", result.Results[0].RawContent)
+	}
+	if len(result.FailedResults) != 1 || !strings.HasSuffix(result.FailedResults[0].URL, "/missing") {
+		t.Errorf("FailedResults = %+v", result.FailedResults)
+	}
+}
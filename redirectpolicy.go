@@ -0,0 +1,77 @@
+package tavily
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// DefaultMaxRedirects matches the limit of net/http's default redirect policy.
+const DefaultMaxRedirects = 10
+
+// ErrTooManyRedirects is returned, wrapped, when a request exceeds RedirectPolicy.MaxRedirects.
+var ErrTooManyRedirects = errors.New("tavily: too many redirects")
+
+// RedirectEvent describes one redirect about to be followed.
+type RedirectEvent struct {
+	From *url.URL
+	To   *url.URL
+	// StatusCode is the status of the redirect response, e.g. 307.
+	StatusCode int
+	// Hop is the 1-based position of this redirect in the chain.
+	Hop int
+}
+
+// RedirectPolicy controls the redirects followed by the HTTP client New
+// builds when Options.HTTPClient is nil, e.g. to audit where requests go
+// through a corporate proxy. It applies to every request of that client,
+// including local ones such as Preflight and ResolveURL. A custom HTTPClient
+// keeps its own CheckRedirect.
+type RedirectPolicy struct {
+	// MaxRedirects caps the redirects followed per request. Zero uses
+	// DefaultMaxRedirects; a negative value follows none and returns the
+	// redirect response as is.
+	MaxRedirects int
+	// OnRedirect is called before every redirect is followed. Returning an
+	// error stops the request with that error.
+	OnRedirect func(event RedirectEvent) error
+}
+
+// checkRedirect implements http.Client.CheckRedirect for p, logging every
+// redirect and running OnRedirect under the client's PanicPolicy.
+func (c *Client) checkRedirect(p *RedirectPolicy) func(*http.Request, []*http.Request) error {
+	limit := p.MaxRedirects
+	if limit == 0 {
+		limit = DefaultMaxRedirects
+	}
+
+	return func(req *http.Request, via []*http.Request) error {
+		if limit < 0 {
+			return http.ErrUseLastResponse
+		}
+		if len(via) > limit {
+			return fmt.Errorf("%w: stopped after %d", ErrTooManyRedirects, limit)
+		}
+
+		event := RedirectEvent{From: via[len(via)-1].URL, To: req.URL, Hop: len(via)}
+		if req.Response != nil {
+			event.StatusCode = req.Response.StatusCode
+		}
+		ctx := req.Context()
+		c.logDebug(ctx, "tavily request redirected",
+			"from", event.From.String(), "to", event.To.String(), "status", event.StatusCode, "hop", event.Hop)
+
+		if p.OnRedirect == nil {
+			return nil
+		}
+		var err error
+		if perr := c.runHook(ctx, "RedirectPolicy.OnRedirect", func() { err = p.OnRedirect(event) }); perr != nil {
+			if c.abortOnPanic() {
+				return perr
+			}
+			return nil
+		}
+		return err
+	}
+}
@@ -0,0 +1,111 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func newRedirectServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/search":
+			http.Redirect(w, r, "/hop/search", http.StatusTemporaryRedirect)
+		case "/hop/search":
+			http.Redirect(w, r, "/final/search", http.StatusPermanentRedirect)
+		default:
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"query": "q", "results": []}`))
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRedirectPolicyObserves(t *testing.T) {
+	server := newRedirectServer(t)
+
+	var events []RedirectEvent
+	client := New("tvly-test-key", &Options{
+		BaseURL: server.URL,
+		Redirects: &RedirectPolicy{OnRedirect: func(e RedirectEvent) error {
+			events = append(events, e)
+			return nil
+		}},
+	})
+
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("OnRedirect called %d times, want 2", len(events))
+	}
+	first, second := events[0], events[1]
+	if first.From.Path != "/search" || first.To.Path != "/hop/search" || first.StatusCode != 307 || first.Hop != 1 {
+		t.Errorf("events[0] = %+v", first)
+	}
+	if second.To.Path != "/final/search" || second.StatusCode != 308 || second.Hop != 2 {
+		t.Errorf("events[1] = %+v", second)
+	}
+}
+
+func TestRedirectPolicyLimits(t *testing.T) {
+	server := newRedirectServer(t)
+	blocked := errors.New("proxy not allowed")
+
+	tests := []struct {
+		name   string
+		policy *RedirectPolicy
+		check  func(error) bool
+	}{
+		{"max redirects", &RedirectPolicy{MaxRedirects: 1}, func(err error) bool {
+			return errors.Is(err, ErrTooManyRedirects)
+		}},
+		{"no redirects", &RedirectPolicy{MaxRedirects: -1}, func(err error) bool {
+			var apiErr *APIError
+			return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusTemporaryRedirect
+		}},
+		{"hook error", &RedirectPolicy{OnRedirect: func(e RedirectEvent) error {
+			if strings.HasPrefix(e.To.Path, "/final") {
+				return blocked
+			}
+			return nil
+		}}, func(err error) bool { return errors.Is(err, blocked) }},
+		{"hook panic", &RedirectPolicy{OnRedirect: func(RedirectEvent) error { panic("boom") }}, func(err error) bool {
+			var perr *PanicError
+			return errors.As(err, &perr) && perr.Hook == "RedirectPolicy.OnRedirect"
+		}},
+	}
+
+	for _, tt := range tests {
+		client := New("tvly-test-key", &Options{
+			BaseURL:   server.URL,
+			Redirects: tt.policy,
+			Retry:     &RetryPolicy{MaxAttempts: 1},
+		})
+		if _, err := client.Search(context.Background(), "q", nil); !tt.check(err) {
+			t.Errorf("%s: Search() error = %v", tt.name, err)
+		}
+	}
+}
+
+func TestRedirectPolicyIgnoredForCustomClient(t *testing.T) {
+	server := newRedirectServer(t)
+
+	called := false
+	client := New("tvly-test-key", &Options{
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{},
+		Redirects: &RedirectPolicy{MaxRedirects: -1, OnRedirect: func(RedirectEvent) error {
+			called = true
+			return nil
+		}},
+	})
+	if _, err := client.Search(context.Background(), "q", nil); err != nil || called {
+		t.Errorf("Search() error = %v, OnRedirect called = %v, want the custom client's policy", err, called)
+	}
+}
@@ -0,0 +1,67 @@
+package tavily
+
+import (
+	"context"
+	"sync"
+)
+
+// ResolveURL follows rawURL's redirect chain from this machine, expanding
+// shortened links such as t.co or bit.ly, and returns the final URL.
+func (c *Client) ResolveURL(ctx context.Context, rawURL string) (string, error) {
+	resp, err := c.probeHeadOrGet(ctx, rawURL)
+	if err != nil {
+		return "", err
+	}
+	resp.Body.Close()
+	return resp.Request.URL.String(), nil
+}
+
+// resolveRedirects resolves urls concurrently and drops URLs that resolve to an
+// already listed page. URLs that cannot be resolved are kept as given. It
+// returns the URLs to send and the input URLs that changed, mapped to their target.
+func (c *Client) resolveRedirects(ctx context.Context, urls []string) ([]string, map[string]string) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return urls, nil
+	}
+	defer done()
+
+	resolved := make([]string, len(urls))
+	sem := make(chan struct{}, DefaultEnrichConcurrency)
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				resolved[i] = u
+				return
+			}
+			defer func() { <-sem }()
+
+			final, err := c.ResolveURL(ctx, u)
+			if err != nil {
+				c.logDebug(ctx, "tavily redirect resolution failed", "url", u, "error", err)
+				final = u
+			}
+			resolved[i] = final
+		}()
+	}
+	wg.Wait()
+
+	var send []string
+	redirects := make(map[string]string)
+	seen := make(map[string]bool)
+	for i, final := range resolved {
+		if final != urls[i] {
+			redirects[urls[i]] = final
+		}
+		if key := normalizeResultURL(final); !seen[key] {
+			seen[key] = true
+			send = append(send, final)
+		}
+	}
+	return send, redirects
+}
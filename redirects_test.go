@@ -0,0 +1,50 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExtractResolveRedirects(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/s/abc", "/s/def":
+			http.Redirect(w, r, "/articles/go", http.StatusMovedPermanently)
+		default:
+			w.WriteHeader(http.StatusOK)
+		}
+	}))
+	defer site.Close()
+
+	var sent []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ExtractRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.URLs
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"results": []}`))
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{
+		BaseURL: api.URL,
+	})
+
+	urls := []string{site.URL + "/s/abc", site.URL + "/s/def", site.URL + "/about"}
+	resp, err := client.Extract(context.Background(), urls, &ExtractOptions{ResolveRedirects: true})
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	want := []string{site.URL + "/articles/go", site.URL + "/about"}
+	if len(sent) != len(want) || sent[0] != want[0] || sent[1] != want[1] {
+		t.Errorf("sent URLs = %v, want %v", sent, want)
+	}
+	if len(resp.Redirects) != 2 || resp.Redirects[urls[1]] != want[0] {
+		t.Errorf("Redirects = %v, want both short links mapped to %s", resp.Redirects, want[0])
+	}
+}
@@ -0,0 +1,157 @@
+package tavily
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// ErrNoFixture is returned by replay clients when no fixture matches a request.
+var ErrNoFixture = errors.New("tavily: no fixture matches request")
+
+// Fixture is a recorded API exchange served by a replay client. Fixture files
+// are JSON documents holding one Fixture or an array of them:
+//
+//	{
+//		"endpoint": "/search",
+//		"request": {"query": "golang", ...},
+//		"response": {"query": "golang", "results": [...]}
+//	}
+//
+// Requests are matched like cache keys (see CacheKey), so key order,
+// whitespace and options left at their API defaults do not matter, together
+// with the HTTP method and the query string, which the endpoint may carry
+// for bodyless calls, e.g. "/usage?period=month".
+type Fixture struct {
+	// Method is the HTTP method. Defaults to POST, or GET without a Request.
+	Method   string          `json:"method,omitempty"`
+	Endpoint string          `json:"endpoint"`
+	Request  json.RawMessage `json:"request"`
+	// Status is the HTTP status to reply with. Defaults to 200.
+	Status   int             `json:"status,omitempty"`
+	Response json.RawMessage `json:"response"`
+}
+
+// NewReplayClient creates a client that serves responses from the fixtures
+// found in every .json file of fsys instead of calling the API, for
+// deterministic end-to-end tests. Unmatched requests fail with ErrNoFixture.
+func NewReplayClient(fsys fs.FS) (*Client, error) {
+	fixtures := make(map[string]Fixture)
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() || path.Ext(name) != ".json" {
+			return err
+		}
+
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		loaded, err := parseFixtures(data)
+		if err != nil {
+			return fmt.Errorf("fixture %s: %w", name, err)
+		}
+		for _, f := range loaded {
+			key, err := f.key()
+			if err != nil {
+				return fmt.Errorf("fixture %s: %w", name, err)
+			}
+			fixtures[key] = f
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to load fixtures: %w", err)
+	}
+
+	return New("tvly-replay", &Options{
+		HTTPClient: &http.Client{Transport: &replayTransport{fixtures: fixtures}},
+		Retry:      &RetryPolicy{MaxAttempts: 1},
+	}), nil
+}
+
+func parseFixtures(data []byte) ([]Fixture, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		var fixtures []Fixture
+		err := json.Unmarshal(data, &fixtures)
+		return fixtures, err
+	}
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, err
+	}
+	return []Fixture{f}, nil
+}
+
+type replayTransport struct {
+	fixtures map[string]Fixture
+}
+
+func (t *replayTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f, ok := t.fixtures[fixtureKey(req.Method, req.URL.Path, req.URL.Query(), body)]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s %s %s", ErrNoFixture, req.Method, req.URL.RequestURI(), body)
+	}
+
+	status := f.Status
+	if status == 0 {
+		status = http.StatusOK
+	}
+	return &http.Response{
+		StatusCode: status,
+		Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Header:     http.Header{"Content-Type": {"application/json"}},
+		Body:       io.NopCloser(bytes.NewReader(f.Response)),
+		Request:    req,
+	}, nil
+}
+
+// key returns the fixtureKey of the request f answers.
+func (f Fixture) key() (string, error) {
+	endpoint, err := url.Parse(f.Endpoint)
+	if err != nil {
+		return "", fmt.Errorf("invalid endpoint: %w", err)
+	}
+	request := bytes.TrimSpace(f.Request)
+	if bytes.Equal(request, []byte("null")) {
+		request = nil
+	}
+	if len(request) > 0 && !json.Valid(request) {
+		return "", errors.New("invalid request JSON")
+	}
+	method := strings.ToUpper(f.Method)
+	if method == "" {
+		method = http.MethodPost
+		if len(request) == 0 {
+			method = http.MethodGet
+		}
+	}
+	return fixtureKey(method, endpoint.Path, endpoint.Query(), request), nil
+}
+
+// fixtureKey identifies an exchange by method, endpoint, query string and
+// the request's cache key.
+func fixtureKey(method, endpoint string, query url.Values, request []byte) string {
+	if len(query) > 0 {
+		// Encode sorts by key.
+		endpoint += "?" + query.Encode()
+	}
+	return method + " " + cacheKey(endpoint, bytes.TrimSpace(request), false)
+}
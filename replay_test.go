@@ -0,0 +1,76 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"testing/fstest"
+)
+
+func TestReplayClient(t *testing.T) {
+	fsys := fstest.MapFS{
+		"search.json": {Data: []byte(`{
+			"endpoint": "/search",
+			"request": {"query": "golang"},
+			"response": {"query": "golang", "results": [{"title": "Go", "url": "https://go.dev", "score": 0.99}]}
+		}`)},
+		"errors/map.json": {Data: []byte(`[{
+			"endpoint": "map",
+			"request": {"url": "https://example.com", "max_depth": 1, "limit": 50},
+			"status": 429,
+			"response": {"detail": {"error": "Rate limit exceeded"}}
+		}]`)},
+		"usage.json": {Data: []byte(`[
+			{"endpoint": "/usage?period=day", "response": {"credits": 1}},
+			{"endpoint": "/usage?period=month", "response": {"credits": 30}},
+			{"method": "DELETE", "endpoint": "/usage?period=month", "response": {"credits": 0}}
+		]`)},
+		"README.md": {Data: []byte("not a fixture")},
+	}
+
+	client, err := NewReplayClient(fsys)
+	if err != nil {
+		t.Fatalf("NewReplayClient() error = %v", err)
+	}
+
+	ctx := context.Background()
+	result, err := client.Search(ctx, "golang", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(result.Results) != 1 || result.Results[0].URL != "https://go.dev" {
+		t.Errorf("Search() results = %+v", result.Results)
+	}
+
+	_, err = client.Map(ctx, "https://example.com", nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsRateLimit() {
+		t.Errorf("Map() error = %v, want rate limit *APIError", err)
+	}
+
+	type usage struct {
+		Credits int `json:"credits"`
+	}
+	for _, tt := range []struct {
+		method, period string
+		want           int
+	}{
+		{http.MethodGet, "day", 1},
+		{http.MethodGet, "month", 30},
+		{http.MethodDelete, "month", 0},
+	} {
+		got, err := CallMethod[url.Values, usage](ctx, client, tt.method, "/usage", url.Values{"period": {tt.period}})
+		if err != nil || got.Credits != tt.want {
+			t.Errorf("CallMethod(%s, period=%s) = %+v, %v, want %d credits", tt.method, tt.period, got, err, tt.want)
+		}
+	}
+	if _, err := CallMethod[url.Values, usage](ctx, client, http.MethodGet, "/usage", url.Values{"period": {"year"}}); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("CallMethod(period=year) error = %v, want %v", err, ErrNoFixture)
+	}
+
+	if _, err := client.Search(ctx, "rust", nil); !errors.Is(err, ErrNoFixture) {
+		t.Errorf("Search() error = %v, want %v", err, ErrNoFixture)
+	}
+}
@@ -0,0 +1,157 @@
+package tavily
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// ReportOptions configures the HTML report renderers.
+type ReportOptions struct {
+	// Title defaults to the query for search reports and the base URL for crawls.
+	Title string
+	// IncludeImages adds <img> tags for the images returned with the results.
+	IncludeImages bool
+	// MaxContentChars truncates each section's content. Zero keeps it whole.
+	MaxContentChars int
+	// Attribution adds an attribution line under every section.
+	Attribution *AttributionPolicy
+}
+
+type reportSection struct {
+	Title       string
+	URL         string
+	Detail      string
+	Attribution string
+	Content     []string
+	Images      []string
+}
+
+type reportData struct {
+	Title    string
+	Answer   string
+	Images   []string
+	Sections []reportSection
+}
+
+// reportTemplate renders a self-contained page: styles are inline and there
+// are no scripts, so reports can be emailed or hosted as a single file.
+var reportTemplate = template.Must(template.New("report").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>{{.Title}}</title>
+<style>
+body{font-family:-apple-system,"Segoe UI",Helvetica,Arial,sans-serif;max-width:52rem;margin:2rem auto;padding:0 1rem;line-height:1.5;color:#1f2328}
+h1{font-size:1.6rem}h2{font-size:1.15rem;margin-bottom:.2rem}
+.answer{background:#f6f8fa;border-left:4px solid #0969da;padding:.75rem 1rem}
+.detail{color:#656d76;font-size:.85rem}
+section{border-top:1px solid #d0d7de;padding-top:.5rem;margin-top:1.25rem}
+img{max-width:100%;max-height:16rem;margin:.25rem .25rem 0 0}
+a{color:#0969da}
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- if .Answer}}
+<p class="answer">{{.Answer}}</p>
+{{- end}}
+{{- range .Images}}
+<img src="{{.}}" alt="" loading="lazy">
+{{- end}}
+{{- range $i, $s := .Sections}}
+<section>
+<h2>{{$i | inc}}. <a href="{{$s.URL}}">{{$s.Title}}</a></h2>
+<div class="detail">{{$s.URL}}{{if $s.Detail}} · {{$s.Detail}}{{end}}</div>
+{{- if $s.Attribution}}
+<div class="detail attribution">{{$s.Attribution}}</div>
+{{- end}}
+{{- range $s.Content}}
+<p>{{.}}</p>
+{{- end}}
+{{- range $s.Images}}
+<img src="{{.}}" alt="" loading="lazy">
+{{- end}}
+</section>
+{{- end}}
+</body>
+</html>
+`))
+
+// RenderSearchHTML writes resp as a self-contained HTML report: the answer,
+// then every result with its title, link, score, date and content.
+func RenderSearchHTML(w io.Writer, resp *SearchResponse, opts *ReportOptions) error {
+	if opts == nil {
+		opts = &ReportOptions{}
+	}
+	data := reportData{
+		Title:  defaultString(opts.Title, resp.Query),
+		Answer: resp.Answer,
+	}
+	if opts.IncludeImages {
+		data.Images = resp.Images
+	}
+	for _, r := range resp.Results {
+		detail := fmt.Sprintf("score %.2f", r.Score)
+		if r.PublishedDate != "" {
+			detail += " · " + r.PublishedDate
+		}
+		content := r.Content
+		if r.RawContent != "" {
+			content = r.RawContent
+		}
+		section := reportSection{
+			Title:   citationTitle(r),
+			URL:     r.URL,
+			Detail:  detail,
+			Content: reportParagraphs(content, opts.MaxContentChars),
+		}
+		if opts.Attribution != nil {
+			section.Attribution = opts.Attribution.searchAttribution(r, resp.Meta).String()
+		}
+		data.Sections = append(data.Sections, section)
+	}
+	return reportTemplate.Execute(w, data)
+}
+
+// RenderCrawlHTML writes resp as a self-contained HTML report with one section
+// per crawled page.
+func RenderCrawlHTML(w io.Writer, resp *CrawlResponse, opts *ReportOptions) error {
+	if opts == nil {
+		opts = &ReportOptions{}
+	}
+	data := reportData{Title: defaultString(opts.Title, "Crawl of "+resp.BaseURL)}
+	for _, r := range resp.Results {
+		section := reportSection{
+			Title:   r.URL,
+			URL:     r.URL,
+			Content: reportParagraphs(r.RawContent, opts.MaxContentChars),
+		}
+		if opts.IncludeImages {
+			section.Images = r.Images
+		}
+		if opts.Attribution != nil {
+			section.Attribution = opts.Attribution.Attribute(r.URL, resp.Meta.RetrievedAt, resp.Meta.RequestID).String()
+		}
+		data.Sections = append(data.Sections, section)
+	}
+	return reportTemplate.Execute(w, data)
+}
+
+// reportParagraphs splits content on blank lines, truncating it to maxChars first.
+func reportParagraphs(content string, maxChars int) []string {
+	if maxChars > 0 && len([]rune(content)) > maxChars {
+		content = string([]rune(content)[:maxChars]) + "…"
+	}
+	var paragraphs []string
+	for p := range strings.SplitSeq(content, "\n\n") {
+		if p = strings.TrimSpace(p); p != "" {
+			paragraphs = append(paragraphs, p)
+		}
+	}
+	return paragraphs
+}
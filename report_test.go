@@ -0,0 +1,61 @@
+package tavily
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRenderSearchHTML(t *testing.T) {
+	resp := &SearchResponse{
+		Query:  "go <generics>",
+		Answer: "Generics arrived in Go 1.18.",
+		Images: []string{"https://example.com/gopher.png"},
+		Results: []SearchResult{
+			{Title: "Tutorial", URL: "https://go.dev/doc/tutorial/generics", Content: "First paragraph.\n\nSecond <b>paragraph</b>.", Score: 0.91},
+		},
+	}
+
+	var out strings.Builder
+	if err := RenderSearchHTML(&out, resp, &ReportOptions{IncludeImages: true}); err != nil {
+		t.Fatalf("RenderSearchHTML() error = %v", err)
+	}
+	html := out.String()
+	for _, want := range []string{
+		"<title>go &lt;generics&gt;</title>",
+		`<p class="answer">Generics arrived in Go 1.18.</p>`,
+		`<h2>1. <a href="https://go.dev/doc/tutorial/generics">Tutorial</a></h2>`,
+		"score 0.91",
+		"<p>Second &lt;b&gt;paragraph&lt;/b&gt;.</p>",
+		`<img src="https://example.com/gopher.png"`,
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("RenderSearchHTML() output missing %q", want)
+		}
+	}
+
+	out.Reset()
+	if err := RenderSearchHTML(&out, resp, nil); err != nil {
+		t.Fatalf("RenderSearchHTML() error = %v", err)
+	}
+	if strings.Contains(out.String(), "<img") {
+		t.Errorf("RenderSearchHTML() without IncludeImages rendered images")
+	}
+}
+
+func TestRenderCrawlHTML(t *testing.T) {
+	resp := &CrawlResponse{
+		BaseURL: "https://docs.example.com",
+		Results: []CrawlResult{
+			{URL: "https://docs.example.com/intro", RawContent: strings.Repeat("x", 50)},
+		},
+	}
+
+	var out strings.Builder
+	if err := RenderCrawlHTML(&out, resp, &ReportOptions{MaxContentChars: 10}); err != nil {
+		t.Fatalf("RenderCrawlHTML() error = %v", err)
+	}
+	if html := out.String(); !strings.Contains(html, "<title>Crawl of https://docs.example.com</title>") ||
+		!strings.Contains(html, "<p>xxxxxxxxxx…</p>") {
+		t.Errorf("RenderCrawlHTML() output = %s", html)
+	}
+}
@@ -0,0 +1,40 @@
+package tavily
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+)
+
+// RequestIDHeader is the header carrying the per-call request ID.
+const RequestIDHeader = "X-Request-ID"
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context whose Tavily calls are sent with the given request ID,
+// so a call can be traced through application logs and Tavily support.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestIDFromContext returns the request ID stored by WithRequestID.
+func RequestIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(requestIDKey{}).(string)
+	return id, ok && id != ""
+}
+
+// ensureRequestID returns ctx carrying a request ID, generating one if needed,
+// so follow-up requests made on behalf of one call share its ID.
+func ensureRequestID(ctx context.Context) (context.Context, string) {
+	if id, ok := RequestIDFromContext(ctx); ok {
+		return ctx, id
+	}
+	id := newRequestID()
+	return WithRequestID(ctx, id), id
+}
+
+func newRequestID() string {
+	var b [16]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
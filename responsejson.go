@@ -0,0 +1,141 @@
+package tavily
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+)
+
+// lenientFloat decodes a JSON number that the API sometimes sends as a
+// string ("1.23"), null or "". Values that are not numbers at all decode to
+// 0 rather than failing the whole response.
+type lenientFloat float64
+
+func (f *lenientFloat) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '"' {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		data = []byte(strings.TrimSpace(s))
+	}
+	v, err := strconv.ParseFloat(string(data), 64)
+	if err != nil {
+		v = 0
+	}
+	*f = lenientFloat(v)
+	return nil
+}
+
+type searchResponseJSON SearchResponse
+
+// UnmarshalJSON tolerates a string response_time and accepts images both as
+// plain URLs and, when descriptions were requested, as {"url", "description"}
+// objects, filling ImageDetails for the latter.
+func (r *SearchResponse) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*searchResponseJSON
+		ResponseTime lenientFloat      `json:"response_time"`
+		Images       []json.RawMessage `json:"images"`
+	}{searchResponseJSON: (*searchResponseJSON)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ResponseTime = float64(aux.ResponseTime)
+
+	r.Images, r.ImageDetails = nil, nil
+	if aux.Images != nil {
+		r.Images = make([]string, 0, len(aux.Images))
+	}
+	for _, raw := range aux.Images {
+		if bytes.HasPrefix(bytes.TrimSpace(raw), []byte("{")) {
+			var img SearchImage
+			if err := json.Unmarshal(raw, &img); err != nil {
+				return err
+			}
+			r.Images = append(r.Images, img.URL)
+			r.ImageDetails = append(r.ImageDetails, img)
+			continue
+		}
+		var u string
+		if err := json.Unmarshal(raw, &u); err != nil {
+			return err
+		}
+		r.Images = append(r.Images, u)
+	}
+	return nil
+}
+
+// MarshalJSON writes images as objects when ImageDetails is set, so the
+// response round-trips through UnmarshalJSON.
+func (r SearchResponse) MarshalJSON() ([]byte, error) {
+	if len(r.ImageDetails) == 0 {
+		return json.Marshal(searchResponseJSON(r))
+	}
+	return json.Marshal(struct {
+		searchResponseJSON
+		Images []SearchImage `json:"images"`
+	}{searchResponseJSON(r), r.ImageDetails})
+}
+
+type searchResultJSON SearchResult
+
+// UnmarshalJSON tolerates a score sent as a string.
+func (r *SearchResult) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*searchResultJSON
+		Score lenientFloat `json:"score"`
+	}{searchResultJSON: (*searchResultJSON)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.Score = float64(aux.Score)
+	return nil
+}
+
+type extractResponseJSON ExtractResponse
+
+// UnmarshalJSON tolerates a response_time sent as a string.
+func (r *ExtractResponse) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*extractResponseJSON
+		ResponseTime lenientFloat `json:"response_time"`
+	}{extractResponseJSON: (*extractResponseJSON)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ResponseTime = float64(aux.ResponseTime)
+	return nil
+}
+
+type crawlResponseJSON CrawlResponse
+
+// UnmarshalJSON tolerates a response_time sent as a string.
+func (r *CrawlResponse) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*crawlResponseJSON
+		ResponseTime lenientFloat `json:"response_time"`
+	}{crawlResponseJSON: (*crawlResponseJSON)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ResponseTime = float64(aux.ResponseTime)
+	return nil
+}
+
+type mapResponseJSON MapResponse
+
+// UnmarshalJSON tolerates a response_time sent as a string.
+func (r *MapResponse) UnmarshalJSON(data []byte) error {
+	aux := struct {
+		*mapResponseJSON
+		ResponseTime lenientFloat `json:"response_time"`
+	}{mapResponseJSON: (*mapResponseJSON)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	r.ResponseTime = float64(aux.ResponseTime)
+	return nil
+}
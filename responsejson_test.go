@@ -0,0 +1,75 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestLenientFloat(t *testing.T) {
+	tests := []struct {
+		input string
+		want  float64
+	}{
+		{`1.5`, 1.5},
+		{`"1.5"`, 1.5},
+		{`" 0.25 "`, 0.25},
+		{`""`, 0},
+		{`null`, 0},
+		{`"n/a"`, 0},
+		{`1e-3`, 0.001},
+	}
+
+	for _, tt := range tests {
+		var got lenientFloat
+		if err := json.Unmarshal([]byte(tt.input), &got); err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", tt.input, err)
+			continue
+		}
+		if float64(got) != tt.want {
+			t.Errorf("Unmarshal(%s) = %v, want %v", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestResponsesTolerateStringNumbers(t *testing.T) {
+	bodies := map[string]string{
+		"/search":  `{"query": "q", "response_time": "1.25", "results": [{"title": "A", "url": "https://a.com", "score": "0.9"}]}`,
+		"/extract": `{"response_time": "2.5", "results": [], "failed_results": []}`,
+		"/crawl":   `{"base_url": "a.com", "response_time": "3", "results": []}`,
+		"/map":     `{"base_url": "a.com", "response_time": null, "results": []}`,
+	}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(bodies[r.URL.Path]))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	ctx := context.Background()
+
+	search, err := client.Search(ctx, "q", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if search.ResponseTime != 1.25 || search.Results[0].Score != 0.9 || search.Results[0].Title != "A" {
+		t.Errorf("Search() = %v, %v, %q, want 1.25, 0.9, \"A\"", search.ResponseTime, search.Results[0].Score, search.Results[0].Title)
+	}
+
+	extract, err := client.Extract(ctx, []string{"https://a.com"}, nil)
+	if err != nil || extract.ResponseTime != 2.5 {
+		t.Errorf("Extract() = %v, %v, want response time 2.5", extract, err)
+	}
+
+	crawl, err := client.Crawl(ctx, "a.com", nil)
+	if err != nil || crawl.ResponseTime != 3 || crawl.BaseURL != "a.com" {
+		t.Errorf("Crawl() = %v, %v, want response time 3", crawl, err)
+	}
+
+	mapped, err := client.Map(ctx, "a.com", nil)
+	if err != nil || mapped.ResponseTime != 0 {
+		t.Errorf("Map() = %v, %v, want response time 0", mapped, err)
+	}
+}
@@ -0,0 +1,107 @@
+package tavily
+
+import (
+	"cmp"
+	"slices"
+)
+
+// The set operations below identify results by canonical URL: scheme, "www."
+// prefix, host case and trailing slashes are ignored. Where a URL occurs more
+// than once, the highest-scoring copy is kept.
+
+// UnionResults returns every distinct result across sets, ordered by descending score.
+func UnionResults(sets ...[]SearchResult) []SearchResult {
+	var union []SearchResult
+	index := make(map[string]int)
+	for _, set := range sets {
+		for _, r := range set {
+			key := normalizeResultURL(r.URL)
+			if i, ok := index[key]; ok {
+				if r.Score > union[i].Score {
+					union[i] = r
+				}
+				continue
+			}
+			index[key] = len(union)
+			union = append(union, r)
+		}
+	}
+	sortByScore(union)
+	return union
+}
+
+// IntersectResults returns the results whose URL appears in every set, ordered
+// by descending score, e.g. the sources two queries agree on.
+func IntersectResults(sets ...[]SearchResult) []SearchResult {
+	if len(sets) == 0 {
+		return nil
+	}
+	counts := make(map[string]int)
+	for _, set := range sets {
+		seen := make(map[string]bool)
+		for _, r := range set {
+			key := normalizeResultURL(r.URL)
+			if !seen[key] {
+				seen[key] = true
+				counts[key]++
+			}
+		}
+	}
+	return slices.DeleteFunc(UnionResults(sets...), func(r SearchResult) bool {
+		return counts[normalizeResultURL(r.URL)] < len(sets)
+	})
+}
+
+// DifferenceResults returns the results of base whose URL appears in none of
+// others, keeping base's order.
+func DifferenceResults(base []SearchResult, others ...[]SearchResult) []SearchResult {
+	exclude := make(map[string]bool)
+	for _, set := range others {
+		for _, r := range set {
+			exclude[normalizeResultURL(r.URL)] = true
+		}
+	}
+	var diff []SearchResult
+	for _, r := range base {
+		if !exclude[normalizeResultURL(r.URL)] {
+			diff = append(diff, r)
+		}
+	}
+	return diff
+}
+
+// MergeResponses combines responses to different queries into one: results are
+// the UnionResults of all responses, images are deduplicated, the first
+// non-empty answer is kept and metadata is summed. Query is left empty.
+func MergeResponses(responses ...*SearchResponse) *SearchResponse {
+	merged := &SearchResponse{}
+	sets := make([][]SearchResult, 0, len(responses))
+	for _, resp := range responses {
+		if resp == nil {
+			continue
+		}
+		sets = append(sets, resp.Results)
+		merged.ResponseTime += resp.ResponseTime
+		if merged.Answer == "" {
+			merged.Answer = resp.Answer
+		}
+		for _, img := range resp.Images {
+			if !slices.Contains(merged.Images, img) {
+				merged.Images = append(merged.Images, img)
+			}
+		}
+		if len(sets) == 1 {
+			merged.Meta = resp.Meta
+		} else {
+			merged.Meta.add(resp.Meta)
+		}
+	}
+	merged.Results = UnionResults(sets...)
+	return merged
+}
+
+func sortByScore(results []SearchResult) {
+	slices.SortStableFunc(results, func(a, b SearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+}
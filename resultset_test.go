@@ -0,0 +1,75 @@
+package tavily
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func resultURLList(results []SearchResult) []string {
+	urls := make([]string, len(results))
+	for i, r := range results {
+		urls[i] = r.URL
+	}
+	return urls
+}
+
+func TestResultSetOperations(t *testing.T) {
+	a := []SearchResult{
+		{URL: "https://go.dev/doc/", Score: 0.9},
+		{URL: "https://example.com/a", Score: 0.5},
+		{URL: "https://blog.example.com/x", Score: 0.4},
+	}
+	b := []SearchResult{
+		{URL: "https://www.go.dev/doc", Score: 0.95},
+		{URL: "https://example.com/b", Score: 0.7},
+		{URL: "https://blog.example.com/x", Score: 0.3},
+	}
+
+	tests := []struct {
+		name string
+		got  []SearchResult
+		want []string
+	}{
+		{
+			name: "union",
+			got:  UnionResults(a, b),
+			want: []string{"https://www.go.dev/doc", "https://example.com/b", "https://example.com/a", "https://blog.example.com/x"},
+		},
+		{
+			name: "intersect",
+			got:  IntersectResults(a, b),
+			want: []string{"https://www.go.dev/doc", "https://blog.example.com/x"},
+		},
+		{
+			name: "difference",
+			got:  DifferenceResults(a, b),
+			want: []string{"https://example.com/a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := resultURLList(tt.got)
+			if len(got) != len(tt.want) {
+				t.Fatalf("%s = %v, want %v", tt.name, got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+					break
+				}
+			}
+		})
+	}
+
+	merged := MergeResponses(
+		&SearchResponse{Answer: "first", Results: a, Meta: ResponseMeta{Attempts: 1, raw: json.RawMessage(`{"answer": "first"}`)}},
+		&SearchResponse{Answer: "second", Results: b, Meta: ResponseMeta{Attempts: 2, raw: json.RawMessage(`{"answer": "second"}`)}},
+	)
+	if merged.Answer != "first" || len(merged.Results) != 4 || merged.Meta.Attempts != 3 {
+		t.Errorf("MergeResponses() answer = %q, results = %d, attempts = %d, want first, 4, 3",
+			merged.Answer, len(merged.Results), merged.Meta.Attempts)
+	}
+	if merged.Raw() != nil {
+		t.Errorf("MergeResponses() Raw() = %s, want nil", merged.Raw())
+	}
+}
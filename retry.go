@@ -0,0 +1,139 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	DefaultMaxAttempts    = 3
+	DefaultRetryBaseDelay = 500 * time.Millisecond
+	DefaultRetryMaxDelay  = 10 * time.Second
+)
+
+// RetryAttempt describes a failed attempt passed to a RetryDecision.
+type RetryAttempt struct {
+	// Time is when the attempt failed, by the client's Clock. Retry-After
+	// dates are measured from it.
+	Time time.Time
+	// Attempt is the 1-based number of the attempt that just failed.
+	Attempt int
+	// Endpoint is the API path, e.g. "/search".
+	Endpoint string
+	// Request is the request payload, e.g. *SearchRequest.
+	Request any
+	// Err is the transport error or *APIError for the attempt.
+	Err error
+	// Response is the HTTP response, or nil when the request never completed.
+	Response *http.Response
+}
+
+// RetryDecision decides whether a failed attempt is retried and how long to wait first.
+// It is only consulted while attempts remain under RetryPolicy.MaxAttempts.
+type RetryDecision func(attempt RetryAttempt) (delay time.Duration, retry bool)
+
+// RetryPolicy configures retries of failed API requests.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first. Defaults to DefaultMaxAttempts; 1 disables retries.
+	MaxAttempts int
+	// BaseDelay is the first backoff delay of the default policy, doubled on every attempt.
+	BaseDelay time.Duration
+	// MaxDelay caps backoff delays of the default policy.
+	MaxDelay time.Duration
+	// Decide overrides the default policy. It can delegate to DefaultRetryDecision.
+	Decide RetryDecision
+}
+
+// DefaultRetryDecision returns the default policy: retry transport errors, 429 and
+// 5xx responses with exponential backoff and jitter, honoring Retry-After.
+func (p *RetryPolicy) DefaultRetryDecision(attempt RetryAttempt) (time.Duration, bool) {
+	// Per-attempt timeouts are retried; a done caller context stops the retry loop itself.
+	if errors.Is(attempt.Err, context.Canceled) {
+		return 0, false
+	}
+
+	if attempt.Response != nil {
+		status := attempt.Response.StatusCode
+		if status != http.StatusTooManyRequests && status < 500 {
+			return 0, false
+		}
+		now := attempt.Time
+		if now.IsZero() {
+			now = SystemClock.Now()
+		}
+		if delay, ok := retryAfter(attempt.Response, now); ok {
+			return min(delay, p.maxDelay()), true
+		}
+	}
+
+	backoff := p.baseDelay() << (attempt.Attempt - 1)
+	if backoff <= 0 || backoff > p.maxDelay() {
+		backoff = p.maxDelay()
+	}
+	jitter := time.Duration(rand.Int64N(int64(backoff)/2 + 1))
+	return backoff/2 + jitter, true
+}
+
+func (p *RetryPolicy) decide(attempt RetryAttempt) (time.Duration, bool) {
+	if attempt.Attempt >= p.maxAttempts() {
+		return 0, false
+	}
+	if p != nil && p.Decide != nil {
+		return p.Decide(attempt)
+	}
+	return p.DefaultRetryDecision(attempt)
+}
+
+func (p *RetryPolicy) maxAttempts() int {
+	if p == nil || p.MaxAttempts == 0 {
+		return DefaultMaxAttempts
+	}
+	return p.MaxAttempts
+}
+
+func (p *RetryPolicy) baseDelay() time.Duration {
+	if p == nil || p.BaseDelay == 0 {
+		return DefaultRetryBaseDelay
+	}
+	return p.BaseDelay
+}
+
+func (p *RetryPolicy) maxDelay() time.Duration {
+	if p == nil || p.MaxDelay == 0 {
+		return DefaultRetryMaxDelay
+	}
+	return p.MaxDelay
+}
+
+// retryAfter parses the Retry-After header, resolving HTTP dates against now.
+func retryAfter(resp *http.Response, now time.Time) (time.Duration, bool) {
+	value := resp.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		return max(t.Sub(now), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
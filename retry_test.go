@@ -0,0 +1,94 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	newServer := func(failures int) (*httptest.Server, *int) {
+		calls := 0
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Content-Type", "application/json")
+			if calls <= failures {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				w.Write([]byte(`{"detail": {"error": "try again"}}`))
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+			w.Write([]byte(`{"query": "test", "response_time": 0.1, "base_url": "https://example.com", "results": []}`))
+		}))
+		return server, &calls
+	}
+
+	t.Run("default policy retries 5xx", func(t *testing.T) {
+		server, calls := newServer(2)
+		defer server.Close()
+
+		client := New("tvly-test-key", &Options{
+			BaseURL: server.URL,
+			Retry:   &RetryPolicy{BaseDelay: time.Millisecond},
+		})
+
+		if _, err := client.Search(context.Background(), "test", nil); err != nil {
+			t.Fatalf("Search() error = %v", err)
+		}
+		if *calls != 3 {
+			t.Errorf("Search() made %d requests, want 3", *calls)
+		}
+	})
+
+	t.Run("custom decision", func(t *testing.T) {
+		server, calls := newServer(2)
+		defer server.Close()
+
+		var seen []RetryAttempt
+		policy := &RetryPolicy{BaseDelay: time.Millisecond}
+		policy.Decide = func(attempt RetryAttempt) (time.Duration, bool) {
+			seen = append(seen, attempt)
+			if attempt.Endpoint == "/search" {
+				return 0, false
+			}
+			return policy.DefaultRetryDecision(attempt)
+		}
+
+		client := New("tvly-test-key", &Options{
+			BaseURL: server.URL,
+			Retry:   policy,
+		})
+
+		_, err := client.Search(context.Background(), "test", nil)
+		var apiErr *APIError
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusServiceUnavailable {
+			t.Fatalf("Search() error = %v, want 503 *APIError", err)
+		}
+		if len(seen) != 1 || seen[0].Response == nil || seen[0].Attempt != 1 {
+			t.Errorf("Decide() calls = %+v", seen)
+		}
+
+		if _, err := client.Map(context.Background(), "https://example.com", nil); err != nil {
+			t.Fatalf("Map() error = %v", err)
+		}
+		if *calls != 3 {
+			t.Errorf("made %d requests, want 3", *calls)
+		}
+	})
+
+	t.Run("client errors are not retried", func(t *testing.T) {
+		policy := &RetryPolicy{}
+		resp := &http.Response{StatusCode: http.StatusBadRequest, Header: http.Header{}}
+		if _, retry := policy.decide(RetryAttempt{Attempt: 1, Response: resp}); retry {
+			t.Error("Expected 400 not to be retried")
+		}
+
+		resp = &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": {"2"}}}
+		if delay, retry := policy.decide(RetryAttempt{Attempt: 1, Response: resp}); !retry || delay != 2*time.Second {
+			t.Errorf("decide() = %v, %v, want 2s, true", delay, retry)
+		}
+	})
+}
@@ -0,0 +1,86 @@
+package tavily
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ScholarlySearch returns the options SearchScholarly starts from: advanced
+// depth, markdown raw content and include_domains limited to common scholarly
+// sources. Merge your own options onto it to change the sources:
+//
+//	opts := tavily.ScholarlySearch().Merge(&tavily.SearchOptions{IncludeDomains: []string{"arxiv.org"}})
+func ScholarlySearch() *SearchOptions {
+	return &SearchOptions{
+		SearchDepth:       string(SearchDepthAdvanced),
+		IncludeRawContent: string(FormatMarkdown),
+		MaxResults:        10,
+		IncludeDomains: []string{
+			"arxiv.org", "biorxiv.org", "medrxiv.org", "ssrn.com", "openreview.net",
+			"aclanthology.org", "pubmed.ncbi.nlm.nih.gov", "ncbi.nlm.nih.gov", "semanticscholar.org",
+			"doi.org", "nature.com", "science.org", "sciencedirect.com", "link.springer.com",
+			"onlinelibrary.wiley.com", "ieeexplore.ieee.org", "dl.acm.org", "jstor.org",
+			"plos.org", "pnas.org", "cell.com", "thelancet.com", "nejm.org", "bmj.com",
+		},
+	}
+}
+
+// SearchScholarly searches academic and reference sources, starting from
+// ScholarlySearch, for research-assistant applications. Search defaults set
+// with WithContextOptions override the preset, e.g. to pick other sources.
+// Use ScholarlyIDs on the results to cite papers by DOI or arXiv ID.
+func (c *Client) SearchScholarly(ctx context.Context, query string) (*SearchResponse, error) {
+	return c.Search(ctx, query, ScholarlySearch().Merge(callOptionsFrom(ctx).Search))
+}
+
+// ScholarlyIDs are the paper identifiers mentioned by a search result.
+type ScholarlyIDs struct {
+	DOIs     []string `json:"dois,omitempty"`
+	ArXivIDs []string `json:"arxiv_ids,omitempty"`
+}
+
+var (
+	doiPattern = regexp.MustCompile(`\b10\.\d{4,9}/[-._;()/:A-Za-z0-9]+`)
+	// arXiv IDs are only taken from an "arXiv:" prefix or an arxiv.org link,
+	// since bare new-style IDs look like any decimal number.
+	arxivPattern = regexp.MustCompile(`(?i)(?:arxiv:\s?|arxiv\.org/(?:abs|pdf)/)(\d{4}\.\d{4,5}|[a-z-]+(?:\.[a-z]{2})?/\d{7})(v\d+)?`)
+)
+
+// ExtractDOIs returns the distinct DOIs in text, in order, compared
+// case-insensitively as DOIs are.
+func ExtractDOIs(text string) []string {
+	var dois []string
+	for _, doi := range doiPattern.FindAllString(text, -1) {
+		// Trailing punctuation usually ends the sentence, not the DOI.
+		doi = strings.TrimRight(doi, ".,;:")
+		if strings.Count(doi, ")") > strings.Count(doi, "(") {
+			doi = strings.TrimSuffix(doi, ")")
+		}
+		doi = strings.TrimSuffix(doi, ".pdf")
+		if !slices.ContainsFunc(dois, func(d string) bool { return strings.EqualFold(d, doi) }) {
+			dois = append(dois, doi)
+		}
+	}
+	return dois
+}
+
+// ExtractArXivIDs returns the distinct arXiv IDs in text, such as "2301.01234"
+// or "hep-th/9901001", in order. Version suffixes are dropped.
+func ExtractArXivIDs(text string) []string {
+	var ids []string
+	for _, m := range arxivPattern.FindAllStringSubmatch(text, -1) {
+		if id := m[1]; !slices.Contains(ids, id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// ScholarlyIDs returns the DOIs and arXiv IDs in the result's URL, title,
+// content and raw content.
+func (r *SearchResult) ScholarlyIDs() ScholarlyIDs {
+	text := strings.Join([]string{r.URL, r.Title, r.Content, r.RawContent}, "\n")
+	return ScholarlyIDs{DOIs: ExtractDOIs(text), ArXivIDs: ExtractArXivIDs(text)}
+}
@@ -0,0 +1,88 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestExtractScholarlyIDs(t *testing.T) {
+	tests := []struct {
+		name      string
+		text      string
+		wantDOIs  []string
+		wantArXiv []string
+	}{
+		{
+			name:     "dois",
+			text:     "See https://doi.org/10.1038/nature14539. Also (doi: 10.1145/3292500.3330701), and 10.1038/NATURE14539 again.",
+			wantDOIs: []string{"10.1038/nature14539", "10.1145/3292500.3330701"},
+		},
+		{
+			name:     "doi with parentheses",
+			text:     "10.1016/S0140-6736(20)30183-5 reported it.",
+			wantDOIs: []string{"10.1016/S0140-6736(20)30183-5"},
+		},
+		{
+			name:      "arxiv",
+			text:      "arXiv:1706.03762v5, https://arxiv.org/pdf/2301.01234.pdf, arxiv.org/abs/hep-th/9901001 and arXiv: 1706.03762.",
+			wantArXiv: []string{"1706.03762", "2301.01234", "hep-th/9901001"},
+		},
+		{
+			name: "bare numbers are not arxiv ids",
+			text: "Revenue grew 2023.12345 percent in version 10.2.",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ExtractDOIs(tt.text); !slices.Equal(got, tt.wantDOIs) {
+				t.Errorf("ExtractDOIs() = %q, want %q", got, tt.wantDOIs)
+			}
+			if got := ExtractArXivIDs(tt.text); !slices.Equal(got, tt.wantArXiv) {
+				t.Errorf("ExtractArXivIDs() = %q, want %q", got, tt.wantArXiv)
+			}
+		})
+	}
+}
+
+func TestSearchScholarly(t *testing.T) {
+	var req SearchRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		req = SearchRequest{}
+		json.NewDecoder(r.Body).Decode(&req)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "attention", "results": [{
+			"url": "https://arxiv.org/abs/1706.03762",
+			"title": "Attention Is All You Need",
+			"content": "Published as doi:10.48550/arXiv.1706.03762."
+		}]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	resp, err := client.SearchScholarly(context.Background(), "attention")
+	if err != nil {
+		t.Fatalf("SearchScholarly() error = %v", err)
+	}
+	if req.SearchDepth != string(SearchDepthAdvanced) || req.IncludeRawContent != "markdown" ||
+		!slices.Equal(req.IncludeDomains, ScholarlySearch().IncludeDomains) {
+		t.Errorf("SearchScholarly() request = %+v, want the ScholarlySearch preset", req)
+	}
+
+	ids := resp.Results[0].ScholarlyIDs()
+	if !slices.Equal(ids.ArXivIDs, []string{"1706.03762"}) || !slices.Equal(ids.DOIs, []string{"10.48550/arXiv.1706.03762"}) {
+		t.Errorf("ScholarlyIDs() = %+v", ids)
+	}
+
+	ctx := WithContextOptions(context.Background(), CallOptions{Search: &SearchOptions{IncludeDomains: []string{"arxiv.org"}}})
+	if _, err := client.SearchScholarly(ctx, "attention"); err != nil {
+		t.Fatalf("SearchScholarly() error = %v", err)
+	}
+	if !slices.Equal(req.IncludeDomains, []string{"arxiv.org"}) || req.SearchDepth != string(SearchDepthAdvanced) {
+		t.Errorf("SearchScholarly() with context options sent %+v, want arxiv.org only", req)
+	}
+}
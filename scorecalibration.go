@@ -0,0 +1,145 @@
+package tavily
+
+import (
+	"math"
+	"sync"
+)
+
+// ScoreNormalization selects how raw relevance scores are rescaled. Tavily
+// scores rank results within one query but are not comparable across queries,
+// topics or depths; normalizing puts them on a common scale.
+type ScoreNormalization string
+
+const (
+	// ScoreMinMax maps the lowest score to 0 and the highest to 1.
+	ScoreMinMax ScoreNormalization = "min_max"
+	// ScoreZScore expresses each score in standard deviations from the mean.
+	ScoreZScore ScoreNormalization = "z_score"
+)
+
+// NormalizeScores rescales the scores of results relative to each other,
+// returning one value per result in the same order. When all scores are
+// equal, min-max yields 0.5 and z-score 0 for every result.
+func NormalizeScores(results []SearchResult, method ScoreNormalization) []float64 {
+	var stats scoreStats
+	for _, r := range results {
+		stats.add(r.Score)
+	}
+	normalized := make([]float64, len(results))
+	for i, r := range results {
+		normalized[i] = stats.normalize(r.Score, method)
+	}
+	return normalized
+}
+
+// ScoreBucket names the topic and depth a search with opts runs at, for use
+// as a ScoreCalibrator bucket. Scores from different buckets are distributed
+// differently and should not share statistics.
+func ScoreBucket(opts *SearchOptions) string {
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+	return defaultString(opts.Topic, DefaultTopic) + "/" + defaultString(opts.SearchDepth, DefaultSearchDepth)
+}
+
+// ScoreCalibrator accumulates score statistics over a session, per bucket, so
+// scores from different queries can be normalized against the same
+// distribution instead of each response's own. The zero value normalizes with
+// ScoreMinMax and is safe for concurrent use.
+type ScoreCalibrator struct {
+	// Method defaults to ScoreMinMax.
+	Method ScoreNormalization
+
+	mu      sync.Mutex
+	buckets map[string]*scoreStats
+}
+
+// Observe adds the scores of results to bucket's statistics.
+func (c *ScoreCalibrator) Observe(bucket string, results ...SearchResult) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.buckets == nil {
+		c.buckets = make(map[string]*scoreStats)
+	}
+	stats := c.buckets[bucket]
+	if stats == nil {
+		stats = &scoreStats{}
+		c.buckets[bucket] = stats
+	}
+	for _, r := range results {
+		stats.add(r.Score)
+	}
+}
+
+// Normalize rescales score against everything observed in bucket. It reports
+// false if nothing has been observed there yet. Min-max results are clamped
+// to [0, 1], so scores outside the observed range do not overshoot.
+func (c *ScoreCalibrator) Normalize(bucket string, score float64) (float64, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.buckets[bucket]
+	if stats == nil {
+		return 0, false
+	}
+	return stats.normalize(score, c.method()), true
+}
+
+// Scores normalizes the scores of results against bucket, one value per
+// result in the same order. It returns nil if nothing has been observed in
+// bucket yet.
+func (c *ScoreCalibrator) Scores(bucket string, results []SearchResult) []float64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	stats := c.buckets[bucket]
+	if stats == nil {
+		return nil
+	}
+	normalized := make([]float64, len(results))
+	for i, r := range results {
+		normalized[i] = stats.normalize(r.Score, c.method())
+	}
+	return normalized
+}
+
+func (c *ScoreCalibrator) method() ScoreNormalization {
+	if c.Method == "" {
+		return ScoreMinMax
+	}
+	return c.Method
+}
+
+// scoreStats tracks the range, mean and variance of a stream of scores,
+// using Welford's algorithm for the variance.
+type scoreStats struct {
+	n        int
+	min, max float64
+	mean, m2 float64
+}
+
+func (s *scoreStats) add(score float64) {
+	if s.n == 0 || score < s.min {
+		s.min = score
+	}
+	if s.n == 0 || score > s.max {
+		s.max = score
+	}
+	s.n++
+	delta := score - s.mean
+	s.mean += delta / float64(s.n)
+	s.m2 += delta * (score - s.mean)
+}
+
+func (s *scoreStats) normalize(score float64, method ScoreNormalization) float64 {
+	switch method {
+	case ScoreZScore:
+		if s.n == 0 || s.m2 == 0 {
+			return 0
+		}
+		return (score - s.mean) / math.Sqrt(s.m2/float64(s.n))
+	default:
+		if s.max == s.min {
+			return 0.5
+		}
+		return min(max((score-s.min)/(s.max-s.min), 0), 1)
+	}
+}
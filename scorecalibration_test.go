@@ -0,0 +1,97 @@
+package tavily
+
+import (
+	"math"
+	"testing"
+)
+
+func scoredResults(scores ...float64) []SearchResult {
+	results := make([]SearchResult, len(scores))
+	for i, s := range scores {
+		results[i] = SearchResult{Score: s}
+	}
+	return results
+}
+
+func closeTo(got, want []float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.Abs(got[i]-want[i]) > 1e-9 {
+			return false
+		}
+	}
+	return true
+}
+
+func TestNormalizeScores(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []SearchResult
+		method  ScoreNormalization
+		want    []float64
+	}{
+		{"min-max", scoredResults(0.2, 0.6, 0.4), ScoreMinMax, []float64{0, 1, 0.5}},
+		{"min-max equal scores", scoredResults(0.7, 0.7), ScoreMinMax, []float64{0.5, 0.5}},
+		{"z-score", scoredResults(1, 3), ScoreZScore, []float64{-1, 1}},
+		{"z-score equal scores", scoredResults(0.7, 0.7), ScoreZScore, []float64{0, 0}},
+		{"empty", nil, ScoreMinMax, []float64{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := NormalizeScores(tt.results, tt.method); !closeTo(got, tt.want) {
+				t.Errorf("NormalizeScores() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScoreBucket(t *testing.T) {
+	tests := []struct {
+		opts *SearchOptions
+		want string
+	}{
+		{nil, "general/basic"},
+		{&SearchOptions{Topic: "news", SearchDepth: "advanced"}, "news/advanced"},
+	}
+
+	for _, tt := range tests {
+		if got := ScoreBucket(tt.opts); got != tt.want {
+			t.Errorf("ScoreBucket(%+v) = %v, want %v", tt.opts, got, tt.want)
+		}
+	}
+}
+
+func TestScoreCalibrator(t *testing.T) {
+	var c ScoreCalibrator
+
+	if _, ok := c.Normalize("general/basic", 0.5); ok {
+		t.Errorf("Normalize() on an empty bucket ok = true, want false")
+	}
+	if got := c.Scores("general/basic", scoredResults(0.5)); got != nil {
+		t.Errorf("Scores() on an empty bucket = %v, want nil", got)
+	}
+
+	c.Observe("general/basic", scoredResults(0.2, 0.4)...)
+	c.Observe("general/basic", scoredResults(0.6)...)
+	c.Observe("news/basic", scoredResults(0.9, 0.95)...)
+
+	// A later response with a narrow range keeps its place in the session.
+	if got, want := c.Scores("general/basic", scoredResults(0.3, 0.5)), []float64{0.25, 0.75}; !closeTo(got, want) {
+		t.Errorf("Scores() = %v, want %v", got, want)
+	}
+	if got, _ := c.Normalize("general/basic", 0.9); got != 1 {
+		t.Errorf("Normalize() above the observed range = %v, want %v", got, 1.0)
+	}
+	if got, _ := c.Normalize("news/basic", 0.9); got != 0 {
+		t.Errorf("Normalize() in another bucket = %v, want %v", got, 0.0)
+	}
+
+	z := ScoreCalibrator{Method: ScoreZScore}
+	z.Observe("b", scoredResults(1, 3)...)
+	if got, _ := z.Normalize("b", 5); math.Abs(got-3) > 1e-9 {
+		t.Errorf("Normalize() z-score = %v, want %v", got, 3.0)
+	}
+}
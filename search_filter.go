@@ -0,0 +1,133 @@
+package tavily
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// resultFilter holds the client-side filters applied to search results.
+type resultFilter struct {
+	minScore  float64
+	perDomain int
+	dedup     bool
+	fill      bool
+	scorer    DomainScorer
+	minDomain float64
+}
+
+func newResultFilter(opts *SearchOptions) resultFilter {
+	return resultFilter{
+		minScore:  opts.MinScore,
+		perDomain: opts.MaxResultsPerDomain,
+		dedup:     opts.Dedup,
+		fill:      opts.FillResults,
+		scorer:    opts.DomainScorer,
+		minDomain: opts.MinDomainScore,
+	}
+}
+
+func (f resultFilter) active() bool {
+	return f.minScore > 0 || f.perDomain > 0 || f.dedup || f.scorer != nil
+}
+
+// apply returns the results that pass the filters, the hosts that reached the
+// per-domain cap or were rejected by the domain scorer, and every host seen in results.
+func (f resultFilter) apply(results []SearchResult) (kept []SearchResult, saturated, seenHosts []string) {
+	counts := make(map[string]int)
+	rejected := make(map[string]bool)
+	seen := make(map[string]bool, len(results))
+	for _, r := range results {
+		key := r.URL
+		if f.dedup {
+			key = normalizeResultURL(r.URL)
+		}
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		host := domainOf(r.URL)
+		if !slices.Contains(seenHosts, host) {
+			seenHosts = append(seenHosts, host)
+		}
+		if r.Score < f.minScore {
+			continue
+		}
+		if f.scorer != nil && (rejected[host] || f.scorer.ScoreDomain(host) < f.minDomain) {
+			if !rejected[host] {
+				rejected[host] = true
+				saturated = append(saturated, host)
+			}
+			continue
+		}
+		if f.perDomain > 0 {
+			if counts[host] >= f.perDomain {
+				continue
+			}
+			counts[host]++
+			if counts[host] == f.perDomain {
+				saturated = append(saturated, host)
+			}
+		}
+		kept = append(kept, r)
+	}
+	return kept, saturated, seenHosts
+}
+
+// filterResults applies f to resp. When that leaves fewer than the requested
+// number of results, it issues one follow-up search that excludes the saturated
+// domains (and, with FillResults, every domain already seen) to top the list back up.
+func (c *Client) filterResults(ctx context.Context, req *SearchRequest, resp *SearchResponse, f resultFilter) error {
+	want := DefaultMaxResults
+	if req.MaxResults != nil {
+		want = *req.MaxResults
+	}
+
+	kept, saturated, seenHosts := f.apply(resp.Results)
+	resp.Results = kept
+	if len(kept) >= want {
+		return nil
+	}
+
+	exclude := saturated
+	if f.fill {
+		exclude = seenHosts
+	}
+	if len(exclude) == 0 {
+		return nil
+	}
+
+	followUp := *req
+	followUp.ExcludeDomains = append(slices.Clone(req.ExcludeDomains), exclude...)
+
+	var more SearchResponse
+	if err := c.doRequest(ctx, http.MethodPost, "/search", &followUp, &more); err != nil {
+		return err
+	}
+
+	resp.ResponseTime += more.ResponseTime
+	resp.Meta.add(more.Meta)
+	resp.Results, _, _ = f.apply(append(kept, more.Results...))
+	if len(resp.Results) > want {
+		resp.Results = resp.Results[:want]
+	}
+	return nil
+}
+
+// normalizeResultURL reduces cosmetic URL differences so the same page
+// reached through different links compares equal.
+func normalizeResultURL(rawURL string) string {
+	u, err := url.Parse(strings.TrimSpace(rawURL))
+	if err != nil {
+		return rawURL
+	}
+	host := strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+	path := strings.TrimSuffix(u.EscapedPath(), "/")
+	if u.RawQuery != "" {
+		path += "?" + u.RawQuery
+	}
+	return host + path
+}
@@ -0,0 +1,81 @@
+package tavily
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// MaxSearchResults is the largest MaxResults value accepted by the API.
+const MaxSearchResults = 20
+
+// validateSearchOptions checks parameter ranges and interdependencies before
+// a request is sent, reporting every problem at once:
+//   - MaxResults must be within 0..MaxSearchResults
+//   - ChunksPerSource must be within 1..3 and requires advanced search depth,
+//     whether set on the call or defaulted by the plan
+//   - IncludeImageDescriptions conflicts with IncludeImages explicitly set to false
+//   - Country must be a known country and requires the general topic
+//   - Days requires the news topic
+//   - Within, Since and Until must not conflict with TimeRange or Days
+func validateSearchOptions(opts *SearchOptions, depth string, now time.Time) error {
+	var problems []string
+
+	if !isSentinel(opts.MaxResults) && (opts.MaxResults < 0 || opts.MaxResults > MaxSearchResults) {
+		problems = append(problems, fmt.Sprintf("MaxResults must be between 0 and %d, got %d", MaxSearchResults, opts.MaxResults))
+	}
+
+	if opts.ChunksPerSource != 0 {
+		if opts.ChunksPerSource < 1 || opts.ChunksPerSource > 3 {
+			problems = append(problems, fmt.Sprintf("ChunksPerSource must be between 1 and 3, got %d", opts.ChunksPerSource))
+		}
+		if depth != string(SearchDepthAdvanced) {
+			problems = append(problems, "ChunksPerSource requires SearchDepth \"advanced\"")
+		}
+	}
+
+	if opts.IncludeImageDescriptions != nil && *opts.IncludeImageDescriptions &&
+		opts.IncludeImages != nil && !*opts.IncludeImages {
+		problems = append(problems, "IncludeImageDescriptions requires IncludeImages, which is set to false")
+	}
+
+	topic := defaultString(opts.Topic, DefaultTopic)
+	if opts.Country != "" {
+		if !Country(opts.Country).IsValid() {
+			problems = append(problems, fmt.Sprintf("unknown Country %q", opts.Country))
+		}
+		if topic != string(TopicGeneral) {
+			problems = append(problems, fmt.Sprintf("Country requires Topic \"general\", got %q", topic))
+		}
+	}
+
+	if opts.Days != 0 && opts.Days != Unset && topic != string(TopicNews) {
+		problems = append(problems, fmt.Sprintf("Days requires Topic \"news\", got %q", topic))
+	}
+
+	if _, _, err := resolveTimeWindow(opts, now); err != nil {
+		problems = append(problems, err.Error())
+	}
+
+	if opts.Enrich != nil && opts.VerifyLinks != nil {
+		problems = append(problems, "Enrich and VerifyLinks cannot both be set; VerifyLinks fills Metadata too")
+	}
+
+	if len(problems) == 0 {
+		return nil
+	}
+	return &APIError{
+		StatusCode: 400,
+		Message:    "invalid search options: " + strings.Join(problems, "; "),
+	}
+}
+
+// includeImages returns the include_images value to send. Image descriptions
+// imply images, so an unset IncludeImages is turned on when descriptions are
+// requested; an explicit false is rejected by validateSearchOptions.
+func includeImages(opts *SearchOptions) *bool {
+	if opts.IncludeImages == nil && opts.IncludeImageDescriptions != nil && *opts.IncludeImageDescriptions {
+		return BoolPtr(true)
+	}
+	return opts.IncludeImages
+}
@@ -0,0 +1,154 @@
+package tavily
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"text/template"
+)
+
+// ContextOptions configures SearchContext.
+type ContextOptions struct {
+	// MaxTokens caps the size of the search response.
+	MaxTokens int
+	// MaxResults defaults to 5.
+	MaxResults int
+	// Formatter renders the context. Nil uses DefaultContextFormatter.
+	Formatter ContextFormatter
+	// Memory leaves out sources already provided earlier in the conversation.
+	Memory *ContextMemory
+}
+
+// ContextFormatter renders search results as context for a prompt.
+type ContextFormatter interface {
+	// FormatHeader writes the text preceding the sources.
+	FormatHeader(w io.Writer, query string) error
+	// FormatSource writes the n-th source, counting from 1.
+	FormatSource(w io.Writer, n int, r SearchResult) error
+}
+
+// DefaultContextFormatter renders the query, then each source's title, URL,
+// result ID and content.
+var DefaultContextFormatter ContextFormatter = defaultContextFormatter{}
+
+type defaultContextFormatter struct{}
+
+func (defaultContextFormatter) FormatHeader(w io.Writer, query string) error {
+	_, err := fmt.Fprintf(w, "Search query: %s\n\n", query)
+	return err
+}
+
+func (defaultContextFormatter) FormatSource(w io.Writer, n int, r SearchResult) error {
+	_, err := fmt.Fprintf(w, "Source %d: %s\nURL: %s\nID: %s\nContent: %s\n\n",
+		n, r.Title, r.URL, resultIDOf(r), r.Content)
+	return err
+}
+
+// ContextSource is the data passed to source templates: the result's fields
+// plus its position N (from 1) and ID.
+type ContextSource struct {
+	SearchResult
+	N  int
+	ID string
+}
+
+type templateContextFormatter struct {
+	header *template.Template
+	source *template.Template
+}
+
+// NewContextTemplate creates a ContextFormatter from text/template sources.
+// The header template receives the query as dot; the source template receives
+// a ContextSource, e.g.
+//
+//	NewContextTemplate("Question: {{.}}\n", "[{{.N}}] {{.Title}} ({{printf \"%.2f\" .Score}})\n{{.Content}}\n\n")
+func NewContextTemplate(header, source string) (ContextFormatter, error) {
+	h, err := template.New("header").Parse(header)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header template: %w", err)
+	}
+	s, err := template.New("source").Parse(source)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source template: %w", err)
+	}
+	return &templateContextFormatter{header: h, source: s}, nil
+}
+
+func (f *templateContextFormatter) FormatHeader(w io.Writer, query string) error {
+	return f.header.Execute(w, query)
+}
+
+func (f *templateContextFormatter) FormatSource(w io.Writer, n int, r SearchResult) error {
+	return f.source.Execute(w, ContextSource{SearchResult: r, N: n, ID: resultIDOf(r)})
+}
+
+// SearchContext searches for query and returns the results rendered as prompt
+// context. The context is formatted lazily, one source at a time, as the
+// returned reader is read.
+func (c *Client) SearchContext(ctx context.Context, query string, opts *ContextOptions) (io.Reader, error) {
+	if opts == nil {
+		opts = &ContextOptions{}
+	}
+
+	result, err := c.Search(ctx, query, &SearchOptions{
+		SearchDepth:       string(SearchDepthAdvanced),
+		MaxResults:        defaultInt(opts.MaxResults, 5),
+		IncludeRawContent: string(FormatText),
+		MaxTokens:         opts.MaxTokens,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("search failed: %w", err)
+	}
+
+	results := result.Results
+	if opts.Memory != nil {
+		results = opts.Memory.apply(results)
+	}
+
+	formatter := opts.Formatter
+	if formatter == nil {
+		formatter = DefaultContextFormatter
+	}
+	return &searchContextReader{c: c, ctx: ctx, query: query, results: results, formatter: formatter}, nil
+}
+
+// searchContextReader formats the context header and one source at a time.
+type searchContextReader struct {
+	c         *Client
+	ctx       context.Context
+	query     string
+	results   []SearchResult
+	formatter ContextFormatter
+	next      int
+	started   bool
+	buf       bytes.Buffer
+}
+
+func (r *searchContextReader) Read(p []byte) (int, error) {
+	for r.buf.Len() == 0 {
+		var err, perr error
+		switch {
+		case !r.started:
+			r.started = true
+			perr = r.c.runHook(r.ctx, "ContextFormatter", func() { err = r.formatter.FormatHeader(&r.buf, r.query) })
+		case r.next < len(r.results):
+			r.next++
+			perr = r.c.runHook(r.ctx, "ContextFormatter", func() { err = r.formatter.FormatSource(&r.buf, r.next, r.results[r.next-1]) })
+		default:
+			return 0, io.EOF
+		}
+		if perr != nil {
+			if r.c.abortOnPanic() {
+				return 0, perr
+			}
+			// Drop whatever the formatter wrote before panicking.
+			r.buf.Reset()
+			continue
+		}
+		if err != nil {
+			return 0, err
+		}
+	}
+	return r.buf.Read(p)
+}
@@ -0,0 +1,78 @@
+package tavily
+
+// ResultChange describes a result present in both searches.
+type ResultChange struct {
+	Result SearchResult
+	// OldRank and NewRank are 1-based positions.
+	OldRank    int
+	NewRank    int
+	ScoreDelta float64
+}
+
+// Moved reports whether the result changed position.
+func (c ResultChange) Moved() bool {
+	return c.OldRank != c.NewRank
+}
+
+// SearchDiff is the difference between two searches of the same query.
+type SearchDiff struct {
+	// Added holds results only in the newer search, in its order.
+	Added []SearchResult
+	// Removed holds results only in the older search, in its order.
+	Removed []SearchResult
+	// Moved holds results whose rank changed, in the newer search's order.
+	Moved []ResultChange
+	// Unchanged holds results that kept their rank, possibly with a new score.
+	Unchanged []ResultChange
+}
+
+// HasChanges reports whether any result was added, removed or moved.
+func (d *SearchDiff) HasChanges() bool {
+	return len(d.Added) > 0 || len(d.Removed) > 0 || len(d.Moved) > 0
+}
+
+// CompareSearches diffs two responses to the same query, matching results by
+// canonical URL, for "what changed since yesterday" monitoring.
+func CompareSearches(before, after *SearchResponse) *SearchDiff {
+	oldRanks := make(map[string]int, len(before.Results))
+	for i, r := range before.Results {
+		if _, dup := oldRanks[normalizeResultURL(r.URL)]; !dup {
+			oldRanks[normalizeResultURL(r.URL)] = i
+		}
+	}
+
+	diff := &SearchDiff{}
+	seen := make(map[string]bool, len(after.Results))
+	for i, r := range after.Results {
+		key := normalizeResultURL(r.URL)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+
+		j, ok := oldRanks[key]
+		if !ok {
+			diff.Added = append(diff.Added, r)
+			continue
+		}
+		change := ResultChange{
+			Result:     r,
+			OldRank:    j + 1,
+			NewRank:    i + 1,
+			ScoreDelta: r.Score - before.Results[j].Score,
+		}
+		if change.Moved() {
+			diff.Moved = append(diff.Moved, change)
+		} else {
+			diff.Unchanged = append(diff.Unchanged, change)
+		}
+	}
+
+	for i, r := range before.Results {
+		key := normalizeResultURL(r.URL)
+		if !seen[key] && oldRanks[key] == i {
+			diff.Removed = append(diff.Removed, r)
+		}
+	}
+	return diff
+}
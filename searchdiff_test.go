@@ -0,0 +1,57 @@
+package tavily
+
+import (
+	"math"
+	"testing"
+)
+
+func TestCompareSearches(t *testing.T) {
+	before := &SearchResponse{Results: []SearchResult{
+		{URL: "https://a.com", Score: 0.9},
+		{URL: "https://b.com", Score: 0.8},
+		{URL: "https://c.com", Score: 0.7},
+		{URL: "https://d.com", Score: 0.6},
+	}}
+	after := &SearchResponse{Results: []SearchResult{
+		{URL: "https://a.com/", Score: 0.95},
+		{URL: "https://c.com", Score: 0.85},
+		{URL: "https://e.com", Score: 0.8},
+		{URL: "https://b.com", Score: 0.5},
+	}}
+
+	diff := CompareSearches(before, after)
+	if !diff.HasChanges() {
+		t.Fatal("HasChanges() = false, want true")
+	}
+	if len(diff.Added) != 1 || diff.Added[0].URL != "https://e.com" {
+		t.Errorf("Added = %v, want [e.com]", resultURLList(diff.Added))
+	}
+	if len(diff.Removed) != 1 || diff.Removed[0].URL != "https://d.com" {
+		t.Errorf("Removed = %v, want [d.com]", resultURLList(diff.Removed))
+	}
+	if len(diff.Unchanged) != 1 || math.Abs(diff.Unchanged[0].ScoreDelta-0.05) > 1e-9 {
+		t.Errorf("Unchanged = %+v, want a.com with delta 0.05", diff.Unchanged)
+	}
+
+	wantMoved := []struct {
+		url      string
+		old, new int
+	}{
+		{url: "https://c.com", old: 3, new: 2},
+		{url: "https://b.com", old: 2, new: 4},
+	}
+	if len(diff.Moved) != len(wantMoved) {
+		t.Fatalf("Moved = %+v, want %d changes", diff.Moved, len(wantMoved))
+	}
+	for i, want := range wantMoved {
+		got := diff.Moved[i]
+		if got.Result.URL != want.url || got.OldRank != want.old || got.NewRank != want.new {
+			t.Errorf("Moved[%d] = %s %d->%d, want %s %d->%d",
+				i, got.Result.URL, got.OldRank, got.NewRank, want.url, want.old, want.new)
+		}
+	}
+
+	if CompareSearches(before, before).HasChanges() {
+		t.Error("CompareSearches() of identical responses reports changes")
+	}
+}
@@ -0,0 +1,104 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+)
+
+type keepRawKey struct{}
+
+// withKeepRaw returns a context whose calls keep the response body regardless
+// of Options.KeepRawResponses.
+func withKeepRaw(ctx context.Context) context.Context {
+	return context.WithValue(ctx, keepRawKey{}, true)
+}
+
+// keepRawFor reports whether a call made with ctx should keep its response body.
+func (c *Client) keepRawFor(ctx context.Context) bool {
+	keep, _ := ctx.Value(keepRawKey{}).(bool)
+	return c.keepRaw || keep
+}
+
+// SearchInto performs a search like Client.Search and decodes each result
+// into T, for structs that add fields the API returns but SearchResult does
+// not model, or leave out the ones the caller does not need.
+//
+// The results follow the returned response: those removed by client-side
+// filtering are left out, in the same order, and content and raw_content
+// carry the response's processed text, e.g. with PII scrubbed. The response
+// body is kept for this call whether or not Options.KeepRawResponses is set.
+func SearchInto[T any](ctx context.Context, c *Client, query string, opts *SearchOptions) ([]T, *SearchResponse, error) {
+	resp, err := c.Search(withKeepRaw(ctx), query, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := decodeResultsInto[T](resp.Meta.rawBodies(), resp.Results)
+	if err != nil {
+		return nil, nil, fmt.Errorf("search failed: %w", err)
+	}
+	return results, resp, nil
+}
+
+// decodeResultsInto decodes the results arrays of bodies into T, keeping only
+// the entries whose URL is among kept. Every kept result must come from one
+// of bodies, which include follow-up calls such as FillResults.
+func decodeResultsInto[T any](bodies []json.RawMessage, kept []SearchResult) ([]T, error) {
+	byURL := make(map[string][]json.RawMessage)
+	for _, raw := range bodies {
+		var body struct {
+			Results []json.RawMessage `json:"results"`
+		}
+		if err := json.Unmarshal(raw, &body); err != nil {
+			return nil, fmt.Errorf("failed to decode results: %w", err)
+		}
+		for _, item := range body.Results {
+			var key struct {
+				URL string `json:"url"`
+			}
+			if err := json.Unmarshal(item, &key); err != nil {
+				return nil, fmt.Errorf("failed to decode results: %w", err)
+			}
+			byURL[key.URL] = append(byURL[key.URL], item)
+		}
+	}
+
+	results := make([]T, 0, len(kept))
+	for _, r := range kept {
+		items := byURL[r.URL]
+		if len(items) == 0 {
+			return nil, fmt.Errorf("result %q is missing from the response body", r.URL)
+		}
+		byURL[r.URL] = items[1:]
+		item, err := withProcessedContent(items[0], r)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode result %q: %w", r.URL, err)
+		}
+		var v T
+		if err := json.Unmarshal(item, &v); err != nil {
+			return nil, fmt.Errorf("failed to decode result %q: %w", r.URL, err)
+		}
+		results = append(results, v)
+	}
+	return results, nil
+}
+
+// withProcessedContent replaces the content fields of item with those of r,
+// which went through the client's content processing.
+func withProcessedContent(item json.RawMessage, r SearchResult) (json.RawMessage, error) {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(item, &fields); err != nil {
+		return nil, err
+	}
+	for key, value := range map[string]string{"content": r.Content, "raw_content": r.RawContent} {
+		if _, ok := fields[key]; !ok && value == "" {
+			continue
+		}
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		fields[key] = encoded
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,129 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+func TestSearchInto(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "go", "results": [
+			{"url": "https://a.example/", "title": "A", "score": 0.9, "favicon": "https://a.example/favicon.ico"},
+			{"url": "https://b.example/", "title": "B", "score": 0.2, "favicon": "https://b.example/favicon.ico"},
+			{"url": "https://c.example/", "title": "C", "score": 0.7}
+		]}`))
+	}))
+	defer server.Close()
+
+	type leanResult struct {
+		URL     string `json:"url"`
+		Favicon string `json:"favicon"`
+	}
+
+	tests := []struct {
+		name string
+		opts *SearchOptions
+		want []leanResult
+	}{
+		{
+			name: "all results",
+			want: []leanResult{
+				{URL: "https://a.example/", Favicon: "https://a.example/favicon.ico"},
+				{URL: "https://b.example/", Favicon: "https://b.example/favicon.ico"},
+				{URL: "https://c.example/"},
+			},
+		},
+		{
+			name: "follows client-side filtering",
+			opts: &SearchOptions{MinScore: 0.5},
+			want: []leanResult{
+				{URL: "https://a.example/", Favicon: "https://a.example/favicon.ico"},
+				{URL: "https://c.example/"},
+			},
+		},
+	}
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, resp, err := SearchInto[leanResult](context.Background(), client, "go", tt.opts)
+			if err != nil {
+				t.Fatalf("SearchInto() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("SearchInto() = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("SearchInto()[%d] = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+			if len(resp.Results) != len(tt.want) {
+				t.Errorf("SearchInto() response results = %v, want %v", len(resp.Results), len(tt.want))
+			}
+		})
+	}
+
+	// The body is only kept for SearchInto, not for later calls.
+	resp, err := client.Search(context.Background(), "go", nil)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if resp.Raw() != nil {
+		t.Errorf("Search() Raw() = %s, want nil", resp.Raw())
+	}
+}
+
+func TestSearchIntoFillResults(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		if len(req.ExcludeDomains) == 0 {
+			w.Write([]byte(`{"query": "go", "results": [
+				{"url": "https://a.example/1", "score": 0.9, "favicon": "https://a.example/favicon.ico"},
+				{"url": "https://b.example/1", "score": 0.1}
+			]}`))
+			return
+		}
+		w.Write([]byte(`{"query": "go", "results": [
+			{"url": "https://c.example/1", "score": 0.8, "favicon": "https://c.example/favicon.ico"}
+		]}`))
+	}))
+	defer server.Close()
+
+	type leanResult struct {
+		URL     string `json:"url"`
+		Favicon string `json:"favicon"`
+	}
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	got, resp, err := SearchInto[leanResult](context.Background(), client, "go", &SearchOptions{
+		MaxResults:  2,
+		MinScore:    0.5,
+		FillResults: true,
+	})
+	if err != nil {
+		t.Fatalf("SearchInto() error = %v", err)
+	}
+	want := []leanResult{
+		{URL: "https://a.example/1", Favicon: "https://a.example/favicon.ico"},
+		{URL: "https://c.example/1", Favicon: "https://c.example/favicon.ico"},
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("SearchInto() = %v, want %v", got, want)
+	}
+	if len(resp.Results) != len(want) {
+		t.Errorf("SearchInto() response results = %v, want %v", len(resp.Results), len(want))
+	}
+	// No single body matches the filled results.
+	if resp.Raw() != nil {
+		t.Errorf("SearchInto() Raw() = %s, want nil after a follow-up", resp.Raw())
+	}
+}
@@ -0,0 +1,144 @@
+package tavily
+
+import (
+	"context"
+	"io"
+)
+
+// Go does not allow a field and a method to share a name, so the grouped
+// services are reached through accessor methods (c.SearchService().News(...))
+// while the flat methods on Client keep working unchanged.
+
+// SearchService groups the search endpoint and its helpers.
+type SearchService struct{ c *Client }
+
+// ExtractService groups the extract endpoint and its helpers.
+type ExtractService struct{ c *Client }
+
+// CrawlService groups the crawl endpoint and its helpers.
+type CrawlService struct{ c *Client }
+
+// MapService groups the map endpoint and its helpers.
+type MapService struct{ c *Client }
+
+// SearchService returns the search operations of c.
+func (c *Client) SearchService() SearchService { return SearchService{c} }
+
+// ExtractService returns the extract operations of c.
+func (c *Client) ExtractService() ExtractService { return ExtractService{c} }
+
+// CrawlService returns the crawl operations of c.
+func (c *Client) CrawlService() CrawlService { return CrawlService{c} }
+
+// MapService returns the map operations of c.
+func (c *Client) MapService() MapService { return MapService{c} }
+
+// Run is Client.Search.
+func (s SearchService) Run(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	return s.c.Search(ctx, query, opts)
+}
+
+// Simple is Client.SearchSimple.
+func (s SearchService) Simple(ctx context.Context, query string) (*SearchResponse, error) {
+	return s.c.SearchSimple(ctx, query)
+}
+
+// WithAnswer is Client.SearchWithAnswer.
+func (s SearchService) WithAnswer(ctx context.Context, query string) (*SearchResponse, error) {
+	return s.c.SearchWithAnswer(ctx, query)
+}
+
+// News is Client.SearchNews.
+func (s SearchService) News(ctx context.Context, query string, days int) (*SearchResponse, error) {
+	return s.c.SearchNews(ctx, query, days)
+}
+
+// Page is Client.SearchPage.
+func (s SearchService) Page(ctx context.Context, query string, page int, opts *SearchOptions) (*SearchResponse, error) {
+	return s.c.SearchPage(ctx, query, page, opts)
+}
+
+// AcrossTopics is Client.SearchAcrossTopics.
+func (s SearchService) AcrossTopics(ctx context.Context, query string, opts *SearchOptions, topics ...Topic) (*SearchResponse, error) {
+	return s.c.SearchAcrossTopics(ctx, query, opts, topics...)
+}
+
+// Context is Client.SearchContext.
+func (s SearchService) Context(ctx context.Context, query string, opts *ContextOptions) (io.Reader, error) {
+	return s.c.SearchContext(ctx, query, opts)
+}
+
+// Answer is Client.Answer.
+func (s SearchService) Answer(ctx context.Context, question string) (string, error) {
+	return s.c.Answer(ctx, question)
+}
+
+// Run is Client.Extract.
+func (s ExtractService) Run(ctx context.Context, urls []string, opts *ExtractOptions) (*ExtractResponse, error) {
+	return s.c.Extract(ctx, urls, opts)
+}
+
+// Simple is Client.ExtractSimple.
+func (s ExtractService) Simple(ctx context.Context, url string) (*ExtractResponse, error) {
+	return s.c.ExtractSimple(ctx, url)
+}
+
+// WithImages is Client.ExtractWithImages.
+func (s ExtractService) WithImages(ctx context.Context, urls []string) (*ExtractResponse, error) {
+	return s.c.ExtractWithImages(ctx, urls)
+}
+
+// ToWriter is Client.ExtractToWriter.
+func (s ExtractService) ToWriter(ctx context.Context, urls []string, opts *ExtractOptions, w io.Writer, format StreamFormat) (*ExtractStreamResult, error) {
+	return s.c.ExtractToWriter(ctx, urls, opts, w, format)
+}
+
+// ResolveURL is Client.ResolveURL.
+func (s ExtractService) ResolveURL(ctx context.Context, url string) (string, error) {
+	return s.c.ResolveURL(ctx, url)
+}
+
+// Run is Client.Crawl.
+func (s CrawlService) Run(ctx context.Context, url string, opts *CrawlOptions) (*CrawlResponse, error) {
+	return s.c.Crawl(ctx, url, opts)
+}
+
+// Documentation is Client.CrawlDocumentation.
+func (s CrawlService) Documentation(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return s.c.CrawlDocumentation(ctx, url, maxPages)
+}
+
+// Blog is Client.CrawlBlog.
+func (s CrawlService) Blog(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return s.c.CrawlBlog(ctx, url, maxPages)
+}
+
+// Changelog is Client.CrawlChangelog.
+func (s CrawlService) Changelog(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return s.c.CrawlChangelog(ctx, url, maxPages)
+}
+
+// Pricing is Client.CrawlPricing.
+func (s CrawlService) Pricing(ctx context.Context, url string, maxPages int) (*CrawlResponse, error) {
+	return s.c.CrawlPricing(ctx, url, maxPages)
+}
+
+// Preflight is Client.Preflight.
+func (s CrawlService) Preflight(ctx context.Context, seedURL string) (*PreflightReport, error) {
+	return s.c.Preflight(ctx, seedURL)
+}
+
+// Preview is Client.PreviewCrawl.
+func (s CrawlService) Preview(ctx context.Context, url string, opts *CrawlOptions) (*CrawlPreview, error) {
+	return s.c.PreviewCrawl(ctx, url, opts)
+}
+
+// Run is Client.Map.
+func (s MapService) Run(ctx context.Context, url string, opts *MapOptions) (*MapResponse, error) {
+	return s.c.Map(ctx, url, opts)
+}
+
+// Site is Client.MapSite.
+func (s MapService) Site(ctx context.Context, url string) (*MapResponse, error) {
+	return s.c.MapSite(ctx, url)
+}
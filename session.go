@@ -0,0 +1,210 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+)
+
+// ErrSessionBudget is matched by *SessionError.
+var ErrSessionBudget = errors.New("tavily: session budget exceeded")
+
+// SessionOptions configures a Session. Zero limits are unlimited.
+type SessionOptions struct {
+	// MaxCalls bounds the number of calls made through the session.
+	MaxCalls int
+	// MaxCredits bounds the estimated credits spent by the session.
+	MaxCredits float64
+	// MaxDuration bounds how long after it was created the session may start calls.
+	MaxDuration time.Duration
+	// ExcludeSeenDomains also excludes the domains of earlier results from
+	// later searches, rather than only dropping the URLs already seen.
+	ExcludeSeenDomains bool
+}
+
+// SessionUsage is what a session has spent so far.
+type SessionUsage struct {
+	Calls   int
+	Credits float64
+	// Elapsed is the summed wall clock time of the session's calls.
+	Elapsed time.Duration
+	// Started is when the session was created.
+	Started time.Time
+}
+
+// SessionError is returned when a call would exceed its session's budget.
+type SessionError struct {
+	Options SessionOptions
+	Usage   SessionUsage
+	// Reason names the limit that was reached.
+	Reason string
+}
+
+func (e *SessionError) Error() string {
+	return fmt.Sprintf("session budget exceeded: %s (%d calls, %.1f credits used)",
+		e.Reason, e.Usage.Calls, e.Usage.Credits)
+}
+
+// Is makes errors.Is(err, ErrSessionBudget) match.
+func (e *SessionError) Is(target error) bool {
+	return target == ErrSessionBudget
+}
+
+// Session groups a sequence of related calls, such as one agent episode. It
+// remembers every search result URL so later searches leave them out, adds
+// up the calls, credits and time spent, and rejects calls once a limit in its
+// SessionOptions is reached. It is safe for concurrent use.
+//
+// Credits are only known once a response arrives, so a session may overshoot
+// MaxCredits by the cost of its in-flight calls.
+type Session struct {
+	c    *Client
+	opts SessionOptions
+
+	mu    sync.Mutex
+	usage SessionUsage
+	seen  map[string]bool
+	urls  []string
+}
+
+// NewSession starts a session making calls with c. opts may be nil.
+func (c *Client) NewSession(opts *SessionOptions) *Session {
+	s := &Session{c: c, seen: make(map[string]bool)}
+	if opts != nil {
+		s.opts = *opts
+	}
+	s.usage.Started = c.clock.Now()
+	return s
+}
+
+// Search runs Client.Search, dropping results whose URL an earlier search in
+// the session returned.
+func (s *Session) Search(ctx context.Context, query string, opts *SearchOptions) (*SearchResponse, error) {
+	if err := s.reserve(); err != nil {
+		return nil, err
+	}
+
+	opts = opts.Clone()
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+	if s.opts.ExcludeSeenDomains {
+		for _, u := range s.SeenURLs() {
+			if host := domainOf(u); !slices.Contains(opts.ExcludeDomains, host) {
+				opts.ExcludeDomains = append(opts.ExcludeDomains, host)
+			}
+		}
+	}
+
+	resp, err := s.c.Search(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recordLocked(resp.Meta)
+	resp.Results = slices.DeleteFunc(resp.Results, func(r SearchResult) bool {
+		return s.seen[normalizeResultURL(r.URL)]
+	})
+	for _, r := range resp.Results {
+		s.seen[normalizeResultURL(r.URL)] = true
+		s.urls = append(s.urls, r.URL)
+	}
+	return resp, nil
+}
+
+// Extract runs Client.Extract within the session's budget.
+func (s *Session) Extract(ctx context.Context, urls []string, opts *ExtractOptions) (*ExtractResponse, error) {
+	if err := s.reserve(); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.Extract(ctx, urls, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.record(resp.Meta)
+	return resp, nil
+}
+
+// Crawl runs Client.Crawl within the session's budget.
+func (s *Session) Crawl(ctx context.Context, url string, opts *CrawlOptions) (*CrawlResponse, error) {
+	if err := s.reserve(); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.Crawl(ctx, url, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.record(resp.Meta)
+	return resp, nil
+}
+
+// Map runs Client.Map within the session's budget.
+func (s *Session) Map(ctx context.Context, url string, opts *MapOptions) (*MapResponse, error) {
+	if err := s.reserve(); err != nil {
+		return nil, err
+	}
+	resp, err := s.c.Map(ctx, url, opts)
+	if err != nil {
+		return nil, err
+	}
+	s.record(resp.Meta)
+	return resp, nil
+}
+
+// Usage returns what the session has spent so far.
+func (s *Session) Usage() SessionUsage {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.usage
+}
+
+// Seen reports whether an earlier search in the session returned url.
+func (s *Session) Seen(url string) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.seen[normalizeResultURL(url)]
+}
+
+// SeenURLs returns the result URLs the session's searches returned, in order.
+func (s *Session) SeenURLs() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.urls)
+}
+
+// reserve counts a call against the session, failing if a limit is reached.
+func (s *Session) reserve() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var reason string
+	switch {
+	case s.opts.MaxCalls > 0 && s.usage.Calls >= s.opts.MaxCalls:
+		reason = fmt.Sprintf("%d calls allowed", s.opts.MaxCalls)
+	case s.opts.MaxCredits > 0 && s.usage.Credits >= s.opts.MaxCredits:
+		reason = fmt.Sprintf("%.1f credits allowed", s.opts.MaxCredits)
+	case s.opts.MaxDuration > 0 && s.c.clock.Now().Sub(s.usage.Started) >= s.opts.MaxDuration:
+		reason = fmt.Sprintf("%v allowed", s.opts.MaxDuration)
+	}
+	if reason != "" {
+		return &SessionError{Options: s.opts, Usage: s.usage, Reason: reason}
+	}
+	s.usage.Calls++
+	return nil
+}
+
+func (s *Session) record(meta ResponseMeta) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.recordLocked(meta)
+}
+
+func (s *Session) recordLocked(meta ResponseMeta) {
+	s.usage.Credits += meta.EstimatedCredits
+	s.usage.Elapsed += meta.ElapsedWallClock
+}
@@ -0,0 +1,140 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily/tavilytest"
+)
+
+func TestSessionExcludesSeenResults(t *testing.T) {
+	var excluded []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req SearchRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		excluded = req.ExcludeDomains
+		w.Header().Set("Content-Type", "application/json")
+		if req.Query == "first" {
+			w.Write([]byte(`{"query": "first", "results": [{"url": "https://a.example/x", "score": 0.9}]}`))
+			return
+		}
+		w.Write([]byte(`{"query": "second", "results": [
+			{"url": "https://a.example/x/", "score": 0.9},
+			{"url": "https://b.example/y", "score": 0.5}
+		]}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name         string
+		opts         *SessionOptions
+		wantExcluded []string
+	}{
+		{"urls", nil, nil},
+		{"domains", &SessionOptions{ExcludeSeenDomains: true}, []string{"a.example"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := New("tvly-test-key", &Options{BaseURL: server.URL})
+			session := client.NewSession(tt.opts)
+			ctx := context.Background()
+
+			if _, err := session.Search(ctx, "first", nil); err != nil {
+				t.Fatalf("Session.Search() error = %v", err)
+			}
+			resp, err := session.Search(ctx, "second", nil)
+			if err != nil {
+				t.Fatalf("Session.Search() error = %v", err)
+			}
+			if got := resultURLList(resp.Results); !slices.Equal(got, []string{"https://b.example/y"}) {
+				t.Errorf("Session.Search() results = %v, want only the unseen result", got)
+			}
+			if !slices.Equal(excluded, tt.wantExcluded) {
+				t.Errorf("Session.Search() exclude_domains = %v, want %v", excluded, tt.wantExcluded)
+			}
+			if want := []string{"https://a.example/x", "https://b.example/y"}; !slices.Equal(session.SeenURLs(), want) {
+				t.Errorf("Session.SeenURLs() = %v, want %v", session.SeenURLs(), want)
+			}
+			if !session.Seen("https://B.example/y") {
+				t.Errorf("Session.Seen() = false, want true")
+			}
+		})
+	}
+}
+
+func TestSessionBudget(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name      string
+		opts      SessionOptions
+		depth     string
+		advance   time.Duration
+		wantCalls int
+	}{
+		{"calls", SessionOptions{MaxCalls: 2}, "", 0, 2},
+		{"credits", SessionOptions{MaxCredits: 3}, "advanced", 0, 2},
+		{"duration", SessionOptions{MaxDuration: time.Minute}, "", time.Minute, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := tavilytest.NewFakeClock(time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC))
+			client := New("tvly-test-key", &Options{BaseURL: server.URL, Clock: clock})
+			session := client.NewSession(&tt.opts)
+			ctx := context.Background()
+
+			var err error
+			for range 5 {
+				if _, err = session.Search(ctx, "q", &SearchOptions{SearchDepth: tt.depth}); err != nil {
+					break
+				}
+				clock.Advance(tt.advance)
+			}
+
+			var serr *SessionError
+			if !errors.As(err, &serr) || !errors.Is(err, ErrSessionBudget) {
+				t.Fatalf("Session.Search() error = %v, want *SessionError", err)
+			}
+			if usage := session.Usage(); usage.Calls != tt.wantCalls {
+				t.Errorf("Session.Usage().Calls = %v, want %v", usage.Calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestSessionUsage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"base_url": "https://example.com", "results": [
+			"https://example.com/a", "https://example.com/b"
+		]}`))
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	session := client.NewSession(nil)
+	for range 2 {
+		if _, err := session.Map(context.Background(), "https://example.com", nil); err != nil {
+			t.Fatalf("Session.Map() error = %v", err)
+		}
+	}
+	usage := session.Usage()
+	if usage.Calls != 2 || usage.Credits != 2 {
+		t.Errorf("Session.Usage() = %+v, want 2 calls and 2 credits", usage)
+	}
+	if usage.Started.IsZero() {
+		t.Errorf("Session.Usage().Started is zero")
+	}
+}
@@ -0,0 +1,84 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"sync"
+)
+
+// errFlightAborted is what waiters see when the leading call panicked.
+var errFlightAborted = errors.New("shared call aborted")
+
+// flightGroup shares one call per key between concurrent callers, in front of
+// a cache the caller owns. Every caller waits on its own context. A leading
+// call that was cancelled, timed out or panicked hands nothing to its
+// waiters; they retry, one of them taking the lead. The zero value is ready
+// to use.
+type flightGroup[T any] struct {
+	mu    sync.Mutex
+	calls map[string]*flightCall[T]
+}
+
+type flightCall[T any] struct {
+	done chan struct{}
+	val  T
+	err  error
+}
+
+// do returns the value lookup finds for key or, failing that, the result of
+// fn, which is called with the caller's ctx and at most once at a time per
+// key. A successful result is passed to store before waiters are released.
+// lookup and store run under the group's lock, so nothing slips between a
+// stored value and the end of its call. shared reports whether the value came
+// from another caller's call.
+func (g *flightGroup[T]) do(ctx context.Context, key string, lookup func() (T, bool), fn func(context.Context) (T, error), store func(T)) (val T, shared bool, err error) {
+	for {
+		g.mu.Lock()
+		if val, ok := lookup(); ok {
+			g.mu.Unlock()
+			return val, false, nil
+		}
+		call, ok := g.calls[key]
+		if !ok {
+			call = &flightCall[T]{done: make(chan struct{}), err: errFlightAborted}
+			if g.calls == nil {
+				g.calls = make(map[string]*flightCall[T])
+			}
+			g.calls[key] = call
+			g.mu.Unlock()
+			val, err = g.lead(ctx, key, call, fn, store)
+			return val, false, err
+		}
+		g.mu.Unlock()
+
+		select {
+		case <-call.done:
+		case <-ctx.Done():
+			return val, false, ctx.Err()
+		}
+		if errors.Is(call.err, errFlightAborted) || errors.Is(call.err, context.Canceled) ||
+			errors.Is(call.err, context.DeadlineExceeded) {
+			if err := ctx.Err(); err != nil {
+				return val, false, err
+			}
+			continue
+		}
+		return call.val, true, call.err
+	}
+}
+
+// lead runs fn for call. The call is released even if fn panics, leaving
+// errFlightAborted for its waiters.
+func (g *flightGroup[T]) lead(ctx context.Context, key string, call *flightCall[T], fn func(context.Context) (T, error), store func(T)) (T, error) {
+	defer func() {
+		g.mu.Lock()
+		if call.err == nil {
+			store(call.val)
+		}
+		delete(g.calls, key)
+		g.mu.Unlock()
+		close(call.done)
+	}()
+	call.val, call.err = fn(ctx)
+	return call.val, call.err
+}
@@ -0,0 +1,140 @@
+package tavily
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// DefaultCompareCategories are the sections CompareSites looks for when none are given.
+var DefaultCompareCategories = []CrawlCategory{
+	CategoryPricing, CategoryDocumentation, CategoryStatus, CategoryCareers, CategoryBlog,
+}
+
+// SiteProfile is one site's column in a SiteComparison.
+type SiteProfile struct {
+	Site string
+	// Sections lists the mapped URLs per compared category, as classified by DetectCategory.
+	Sections map[CrawlCategory][]string
+	// Pages is the number of URLs the map returned.
+	Pages int
+	// Err is set when mapping the site failed; Sections is then empty.
+	Err error
+}
+
+// SiteComparison is a matrix of which sections several sites have.
+type SiteComparison struct {
+	Categories []CrawlCategory
+	Sites      []SiteProfile
+
+	Meta ResponseMeta
+}
+
+// CompareSites maps each site in parallel with opts and records which of
+// categories (DefaultCompareCategories when empty) each one has, e.g. to see
+// which competitors publish pricing, docs, a status page or careers. A site
+// that fails to map gets its Err set; CompareSites only fails when every
+// site does.
+func (c *Client) CompareSites(ctx context.Context, sites []string, opts *MapOptions, categories ...CrawlCategory) (*SiteComparison, error) {
+	if len(sites) == 0 {
+		return nil, &APIError{
+			StatusCode: 400,
+			Message:    "at least one site is required",
+		}
+	}
+	if len(categories) == 0 {
+		categories = DefaultCompareCategories
+	}
+
+	responses := make([]*MapResponse, len(sites))
+	errs := make([]error, len(sites))
+
+	var wg sync.WaitGroup
+	for i, site := range sites {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			var siteOpts *MapOptions
+			if opts != nil {
+				siteOpts = opts.Clone()
+			}
+			responses[i], errs[i] = c.Map(ctx, site, siteOpts)
+		}()
+	}
+	wg.Wait()
+
+	result := &SiteComparison{Categories: categories}
+	result.Meta.CacheHit = true
+	failed := 0
+	for i, site := range sites {
+		profile := SiteProfile{Site: site, Sections: make(map[CrawlCategory][]string)}
+		if errs[i] != nil {
+			profile.Err = errs[i]
+			failed++
+			result.Sites = append(result.Sites, profile)
+			continue
+		}
+
+		resp := responses[i]
+		elapsed := max(result.Meta.ElapsedWallClock, resp.Meta.ElapsedWallClock)
+		result.Meta.add(resp.Meta)
+		result.Meta.ElapsedWallClock = elapsed
+
+		profile.Pages = len(resp.Results)
+		for _, category := range categories {
+			if urls := resp.FilterCategories(category); len(urls) > 0 {
+				profile.Sections[category] = urls
+			}
+		}
+		result.Sites = append(result.Sites, profile)
+	}
+
+	if failed == len(sites) {
+		return nil, fmt.Errorf("compare sites failed: %w", errs[0])
+	}
+	return result, nil
+}
+
+// Has reports whether site was found to have a category section.
+func (s *SiteComparison) Has(site string, category CrawlCategory) bool {
+	for _, p := range s.Sites {
+		if p.Site == site {
+			return len(p.Sections[category]) > 0
+		}
+	}
+	return false
+}
+
+// WriteMarkdown writes the comparison as a markdown table with one row per
+// site and one column per category, showing the number of matching pages.
+// Sites that failed to map show "error".
+func (s *SiteComparison) WriteMarkdown(w io.Writer) error {
+	var b strings.Builder
+	b.WriteString("| Site |")
+	for _, c := range s.Categories {
+		fmt.Fprintf(&b, " %s |", c)
+	}
+	b.WriteString("\n| --- |")
+	b.WriteString(strings.Repeat(" --- |", len(s.Categories)))
+	b.WriteString("\n")
+
+	for _, p := range s.Sites {
+		fmt.Fprintf(&b, "| %s |", p.Site)
+		for _, c := range s.Categories {
+			switch n := len(p.Sections[c]); {
+			case p.Err != nil:
+				b.WriteString(" error |")
+			case n == 0:
+				b.WriteString(" - |")
+			default:
+				fmt.Fprintf(&b, " %d |", n)
+			}
+		}
+		b.WriteString("\n")
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
@@ -0,0 +1,86 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCompareSites(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req MapRequest
+		json.NewDecoder(r.Body).Decode(&req)
+
+		w.Header().Set("Content-Type", "application/json")
+		switch req.URL {
+		case "a.example":
+			w.Write([]byte(`{"base_url": "a.example", "results": ["https://a.example/pricing", "https://a.example/docs/x", "https://a.example/docs/y"]}`))
+		case "b.example":
+			w.Write([]byte(`{"base_url": "b.example", "results": ["https://status.b.example/", "https://b.example/careers"]}`))
+		default:
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"detail": {"error": "invalid url"}}`))
+		}
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	result, err := client.CompareSites(context.Background(), []string{"a.example", "b.example", "bad"}, nil,
+		CategoryPricing, CategoryDocumentation, CategoryStatus, CategoryCareers)
+	if err != nil {
+		t.Fatalf("CompareSites() error = %v", err)
+	}
+
+	tests := []struct {
+		site     string
+		category CrawlCategory
+		want     bool
+	}{
+		{"a.example", CategoryPricing, true},
+		{"a.example", CategoryStatus, false},
+		{"b.example", CategoryStatus, true},
+		{"b.example", CategoryCareers, true},
+		{"bad", CategoryPricing, false},
+	}
+	for _, tt := range tests {
+		if got := result.Has(tt.site, tt.category); got != tt.want {
+			t.Errorf("Has(%q, %s) = %v, want %v", tt.site, tt.category, got, tt.want)
+		}
+	}
+	if result.Sites[2].Err == nil {
+		t.Error("Expected an error for the failing site")
+	}
+
+	var b strings.Builder
+	result.WriteMarkdown(&b)
+	want := `| Site | Pricing | Documentation | Status | Careers |
+| --- | --- | --- | --- | --- |
+| a.example | 1 | 2 | - | - |
+| b.example | - | - | 1 | 1 |
+| bad | error | error | error | error |
+`
+	if b.String() != want {
+		t.Errorf("WriteMarkdown() =\n%s\nwant\n%s", b.String(), want)
+	}
+}
+
+func TestCompareSitesAllFail(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer server.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: server.URL})
+	if _, err := client.CompareSites(context.Background(), []string{"a.example"}, nil); err == nil {
+		t.Error("Expected an error when every site fails")
+	}
+	_, err := client.CompareSites(context.Background(), nil, nil)
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsBadRequest() {
+		t.Errorf("CompareSites(nil) error = %v, want bad request", err)
+	}
+}
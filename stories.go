@@ -0,0 +1,137 @@
+package tavily
+
+import (
+	"cmp"
+	"slices"
+)
+
+// DefaultStoryThreshold is the similarity at which ClusterStories treats two
+// results as covering the same story.
+const DefaultStoryThreshold = 0.4
+
+// StoryCluster is a group of results covering the same story, typically one
+// wire story republished by several outlets.
+type StoryCluster struct {
+	// Representative is the cluster's highest-scoring result, or the earliest
+	// published among equally scored ones.
+	Representative SearchResult
+	// Results lists every result in the cluster, representative first, by
+	// descending score.
+	Results []SearchResult
+	// Publishers lists the distinct domains in the cluster, in result order.
+	Publishers []string
+}
+
+// ClusterStories groups results whose titles and content share enough key
+// terms to be the same story, so news digests show each story once rather
+// than as variants from every publisher. Two results belong together when
+// their similarity (Jaccard index of key terms) reaches threshold, or
+// DefaultStoryThreshold when threshold is 0; clusters are joined
+// transitively. Clusters are ordered by their representative's score.
+func ClusterStories(results []SearchResult, threshold float64) []StoryCluster {
+	if threshold <= 0 {
+		threshold = DefaultStoryThreshold
+	}
+
+	terms := make([]map[string]bool, len(results))
+	for i, r := range results {
+		terms[i] = make(map[string]bool)
+		for _, t := range keyTerms(r.Title + " " + r.Content) {
+			terms[i][t] = true
+		}
+	}
+
+	parent := make([]int, len(results))
+	for i := range parent {
+		parent[i] = i
+	}
+	var find func(int) int
+	find = func(i int) int {
+		if parent[i] != i {
+			parent[i] = find(parent[i])
+		}
+		return parent[i]
+	}
+	for i := range results {
+		for j := i + 1; j < len(results); j++ {
+			if jaccard(terms[i], terms[j]) >= threshold {
+				parent[find(j)] = find(i)
+			}
+		}
+	}
+
+	index := make(map[int]int)
+	var clusters []StoryCluster
+	for i, r := range results {
+		root := find(i)
+		k, ok := index[root]
+		if !ok {
+			k = len(clusters)
+			index[root] = k
+			clusters = append(clusters, StoryCluster{})
+		}
+		clusters[k].Results = append(clusters[k].Results, r)
+	}
+
+	for i := range clusters {
+		c := &clusters[i]
+		slices.SortStableFunc(c.Results, compareStoryResults)
+		c.Representative = c.Results[0]
+		for _, r := range c.Results {
+			if d := domainOf(r.URL); !slices.Contains(c.Publishers, d) {
+				c.Publishers = append(c.Publishers, d)
+			}
+		}
+	}
+	slices.SortStableFunc(clusters, func(a, b StoryCluster) int {
+		return compareStoryResults(a.Representative, b.Representative)
+	})
+	return clusters
+}
+
+// Stories clusters the response's results; see ClusterStories.
+func (r *SearchResponse) Stories(threshold float64) []StoryCluster {
+	return ClusterStories(r.Results, threshold)
+}
+
+// DedupeStories returns one representative result per story, in cluster order.
+func DedupeStories(results []SearchResult, threshold float64) []SearchResult {
+	clusters := ClusterStories(results, threshold)
+	deduped := make([]SearchResult, len(clusters))
+	for i, c := range clusters {
+		deduped[i] = c.Representative
+	}
+	return deduped
+}
+
+// compareStoryResults orders by descending score, then earliest publication,
+// with undated results last.
+func compareStoryResults(a, b SearchResult) int {
+	if c := cmp.Compare(b.Score, a.Score); c != 0 {
+		return c
+	}
+	ta, okA := ParsePublishedDate(a.PublishedDate)
+	tb, okB := ParsePublishedDate(b.PublishedDate)
+	switch {
+	case okA && okB:
+		return ta.Compare(tb)
+	case okA:
+		return -1
+	case okB:
+		return 1
+	}
+	return 0
+}
+
+func jaccard(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+	shared := 0
+	for t := range a {
+		if b[t] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
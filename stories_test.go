@@ -0,0 +1,56 @@
+package tavily
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestClusterStories(t *testing.T) {
+	results := []SearchResult{
+		{URL: "https://reuters.com/fed", Title: "Fed raises interest rates by a quarter point",
+			Content: "The Federal Reserve raised interest rates by a quarter point on Wednesday, citing persistent inflation.",
+			Score:   0.7, PublishedDate: "2025-03-19"},
+		{URL: "https://www.example-news.com/markets/fed", Title: "Federal Reserve raises rates a quarter point",
+			Content: "The Federal Reserve raised interest rates by a quarter point on Wednesday, citing persistent inflation, Reuters reports.",
+			Score:   0.9, PublishedDate: "2025-03-19"},
+		{URL: "https://local.example/fed", Title: "Fed raises interest rates",
+			Content: "The Federal Reserve raised interest rates by a quarter point Wednesday citing persistent inflation.",
+			Score:   0.9, PublishedDate: "2025-03-18"},
+		{URL: "https://sports.example/final", Title: "Underdogs win the championship final",
+			Content: "A late goal settled the championship final in extra time.",
+			Score:   0.8},
+	}
+
+	clusters := ClusterStories(results, 0)
+	if len(clusters) != 2 {
+		t.Fatalf("ClusterStories() = %d clusters, want 2", len(clusters))
+	}
+
+	fed := clusters[0]
+	if fed.Representative.URL != "https://local.example/fed" {
+		t.Errorf("ClusterStories() representative = %v, want the earliest top-scoring result", fed.Representative.URL)
+	}
+	if got := resultURLList(fed.Results); !slices.Equal(got, []string{
+		"https://local.example/fed", "https://www.example-news.com/markets/fed", "https://reuters.com/fed",
+	}) {
+		t.Errorf("ClusterStories() results = %v", got)
+	}
+	if want := []string{"local.example", "www.example-news.com", "reuters.com"}; !slices.Equal(fed.Publishers, want) {
+		t.Errorf("ClusterStories() publishers = %v, want %v", fed.Publishers, want)
+	}
+	if clusters[1].Representative.URL != "https://sports.example/final" || len(clusters[1].Results) != 1 {
+		t.Errorf("ClusterStories() second cluster = %+v", clusters[1])
+	}
+
+	if got := resultURLList(DedupeStories(results, 0)); !slices.Equal(got, []string{
+		"https://local.example/fed", "https://sports.example/final",
+	}) {
+		t.Errorf("DedupeStories() = %v", got)
+	}
+
+	// A threshold of 1 only joins results with identical key terms, here the
+	// Reuters copy and the local rewrite of it.
+	if got := len((&SearchResponse{Results: results}).Stories(1)); got != 3 {
+		t.Errorf("Stories(1) = %d clusters, want 3", got)
+	}
+}
@@ -0,0 +1,136 @@
+package tavily
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+)
+
+// Default field limits for StructuredOptions.
+const (
+	DefaultStructuredTitleLength   = 200
+	DefaultStructuredContentLength = 1000
+)
+
+// StructuredOptions configures SearchResponse.Structured and StructuredSchema.
+type StructuredOptions struct {
+	// MaxResults caps the number of results. Zero keeps them all.
+	MaxResults int
+	// MaxTitleLength caps titles in bytes. Defaults to DefaultStructuredTitleLength.
+	MaxTitleLength int
+	// MaxContentLength caps content in bytes, cut at a sentence boundary where
+	// possible. Defaults to DefaultStructuredContentLength.
+	MaxContentLength int
+}
+
+func (o *StructuredOptions) titleLength() int {
+	if o == nil {
+		return DefaultStructuredTitleLength
+	}
+	return defaultInt(o.MaxTitleLength, DefaultStructuredTitleLength)
+}
+
+func (o *StructuredOptions) contentLength() int {
+	if o == nil {
+		return DefaultStructuredContentLength
+	}
+	return defaultInt(o.MaxContentLength, DefaultStructuredContentLength)
+}
+
+// StructuredOutput is a search response shaped for JSON-mode and
+// structured-output LLM calls: flat, with every field always present, in a
+// fixed order, and within the lengths declared by StructuredSchema.
+type StructuredOutput struct {
+	Query   string             `json:"query"`
+	Answer  string             `json:"answer"`
+	Results []StructuredResult `json:"results"`
+}
+
+// StructuredResult is one result of a StructuredOutput.
+type StructuredResult struct {
+	// ID is the result's stable ResultID, for citing it back.
+	ID            string  `json:"id"`
+	Rank          int     `json:"rank"`
+	Title         string  `json:"title"`
+	URL           string  `json:"url"`
+	Content       string  `json:"content"`
+	Score         float64 `json:"score"`
+	PublishedDate string  `json:"published_date"`
+}
+
+// Structured returns the response shaped by opts, which may be nil. Scores
+// are rounded to two decimals and ranks count from 1.
+func (r *SearchResponse) Structured(opts *StructuredOptions) *StructuredOutput {
+	results := r.Results
+	if opts != nil && opts.MaxResults > 0 && len(results) > opts.MaxResults {
+		results = results[:opts.MaxResults]
+	}
+
+	out := &StructuredOutput{
+		Query:   r.Query,
+		Answer:  r.Answer,
+		Results: make([]StructuredResult, len(results)),
+	}
+	title := TruncateOptions{MaxBytes: opts.titleLength(), Marker: "…"}
+	content := TruncateOptions{Policy: TruncateSentence, MaxBytes: opts.contentLength(), Marker: "…"}
+	for i, res := range results {
+		out.Results[i] = StructuredResult{
+			ID:            resultIDOf(res),
+			Rank:          i + 1,
+			URL:           res.URL,
+			Score:         math.Round(res.Score*100) / 100,
+			PublishedDate: res.PublishedDate,
+		}
+		out.Results[i].Title, _ = Truncate(res.Title, title)
+		out.Results[i].Content, _ = Truncate(res.Content, content)
+	}
+	return out
+}
+
+// StructuredSchema returns the JSON Schema of StructuredOutput under opts,
+// ready to pass as the response or tool schema of a structured-output call.
+// Properties are listed in field order and all are required, as strict
+// structured-output modes expect.
+func StructuredSchema(opts *StructuredOptions) json.RawMessage {
+	type property struct {
+		Name   string
+		Schema string
+	}
+	object := func(props ...property) string {
+		s := `{"type":"object","properties":{`
+		required := `[`
+		for i, p := range props {
+			if i > 0 {
+				s += ","
+				required += ","
+			}
+			s += `"` + p.Name + `":` + p.Schema
+			required += `"` + p.Name + `"`
+		}
+		return s + `},"required":` + required + `],"additionalProperties":false}`
+	}
+	maxLength := func(n int) string {
+		return fmt.Sprintf(`{"type":"string","maxLength":%d}`, n)
+	}
+
+	result := object(
+		property{"id", `{"type":"string"}`},
+		property{"rank", `{"type":"integer"}`},
+		property{"title", maxLength(opts.titleLength())},
+		property{"url", `{"type":"string"}`},
+		property{"content", maxLength(opts.contentLength())},
+		property{"score", `{"type":"number"}`},
+		property{"published_date", `{"type":"string"}`},
+	)
+	results := `{"type":"array","items":` + result
+	if opts != nil && opts.MaxResults > 0 {
+		results += fmt.Sprintf(`,"maxItems":%d`, opts.MaxResults)
+	}
+	results += `}`
+
+	return json.RawMessage(object(
+		property{"query", `{"type":"string"}`},
+		property{"answer", `{"type":"string"}`},
+		property{"results", results},
+	))
+}
@@ -0,0 +1,90 @@
+package tavily
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestStructured(t *testing.T) {
+	resp := &SearchResponse{
+		Query:  "q",
+		Answer: "A.",
+		Results: []SearchResult{
+			{Title: "A long title here", URL: "https://a.example/", Content: "One. Two. Three.", Score: 0.98765},
+			{Title: "B", URL: "https://b.example/", Content: "Short.", Score: 0.5, PublishedDate: "2025-01-02"},
+			{Title: "C", URL: "https://c.example/", Score: 0.1},
+		},
+	}
+
+	out := resp.Structured(&StructuredOptions{MaxResults: 2, MaxTitleLength: 9, MaxContentLength: 12})
+	if len(out.Results) != 2 {
+		t.Fatalf("Structured() results = %d, want 2", len(out.Results))
+	}
+	first := out.Results[0]
+	want := StructuredResult{
+		ID:      ResultID(resp.Results[0]),
+		Rank:    1,
+		Title:   "A long…",
+		URL:     "https://a.example/",
+		Content: "One. Two.…",
+		Score:   0.99,
+	}
+	if first != want {
+		t.Errorf("Structured() first = %+v, want %+v", first, want)
+	}
+	if out.Results[1].Rank != 2 || out.Results[1].Content != "Short." {
+		t.Errorf("Structured() second = %+v", out.Results[1])
+	}
+
+	b, err := json.Marshal(out.Results[1])
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	if got, want := string(b), `{"id":"`+out.Results[1].ID+`","rank":2,"title":"B","url":"https://b.example/","content":"Short.","score":0.5,"published_date":"2025-01-02"}`; got != want {
+		t.Errorf("json.Marshal() = %s, want %s", got, want)
+	}
+}
+
+func TestStructuredSchema(t *testing.T) {
+	raw := StructuredSchema(&StructuredOptions{MaxResults: 3, MaxContentLength: 500})
+
+	var schema struct {
+		Required   []string `json:"required"`
+		Properties struct {
+			Results struct {
+				MaxItems int `json:"maxItems"`
+				Items    struct {
+					Required   []string `json:"required"`
+					Properties map[string]struct {
+						MaxLength int `json:"maxLength"`
+					} `json:"properties"`
+				} `json:"items"`
+			} `json:"results"`
+		} `json:"properties"`
+	}
+	if err := json.Unmarshal(raw, &schema); err != nil {
+		t.Fatalf("StructuredSchema() is not valid JSON: %v\n%s", err, raw)
+	}
+
+	if want := []string{"query", "answer", "results"}; !slices.Equal(schema.Required, want) {
+		t.Errorf("StructuredSchema() required = %v, want %v", schema.Required, want)
+	}
+	results := schema.Properties.Results
+	if results.MaxItems != 3 {
+		t.Errorf("StructuredSchema() maxItems = %v, want %v", results.MaxItems, 3)
+	}
+	if want := []string{"id", "rank", "title", "url", "content", "score", "published_date"}; !slices.Equal(results.Items.Required, want) {
+		t.Errorf("StructuredSchema() item required = %v, want %v", results.Items.Required, want)
+	}
+	if got := results.Items.Properties["content"].MaxLength; got != 500 {
+		t.Errorf("StructuredSchema() content maxLength = %v, want %v", got, 500)
+	}
+	if got := results.Items.Properties["title"].MaxLength; got != DefaultStructuredTitleLength {
+		t.Errorf("StructuredSchema() title maxLength = %v, want %v", got, DefaultStructuredTitleLength)
+	}
+	if !strings.Contains(string(raw), `"properties":{"id":`) {
+		t.Errorf("StructuredSchema() does not list properties in field order: %s", raw)
+	}
+}
@@ -0,0 +1,135 @@
+package tavilytest
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+)
+
+// FakeClock is a tavily.Clock whose time only moves when Advance is called,
+// so retry backoff, pacing and cache expiry can be tested without real sleeps:
+//
+//	clock := tavilytest.NewFakeClock(time.Now())
+//	client := tavily.New("tvly-test-key", &tavily.Options{Clock: clock})
+//
+// With AutoAdvance set, Sleep advances the clock by the requested duration
+// and returns at once, which suits tests that only inspect the schedule
+// through Sleeps. FakeClock is safe for concurrent use.
+type FakeClock struct {
+	// AutoAdvance makes Sleep advance the clock instead of blocking.
+	AutoAdvance bool
+
+	mu      sync.Mutex
+	now     time.Time
+	sleeps  []time.Duration
+	waiters []*fakeWaiter
+	changed chan struct{}
+}
+
+type fakeWaiter struct {
+	until time.Time
+	done  chan struct{}
+}
+
+// NewFakeClock returns a FakeClock set to start.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{now: start, changed: make(chan struct{})}
+}
+
+// Now returns the fake current time.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Sleep blocks until the clock has been advanced by d or ctx is done.
+func (c *FakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	c.mu.Lock()
+	c.sleeps = append(c.sleeps, d)
+	if c.AutoAdvance {
+		c.now = c.now.Add(d)
+		c.mu.Unlock()
+		return ctx.Err()
+	}
+	w := &fakeWaiter{until: c.now.Add(d), done: make(chan struct{})}
+	c.waiters = append(c.waiters, w)
+	c.notify()
+	c.mu.Unlock()
+
+	select {
+	case <-w.done:
+		return nil
+	case <-ctx.Done():
+		c.mu.Lock()
+		c.waiters = slices.DeleteFunc(c.waiters, func(o *fakeWaiter) bool { return o == w })
+		c.notify()
+		c.mu.Unlock()
+		return ctx.Err()
+	}
+}
+
+// Advance moves the clock forward by d, waking every sleeper whose deadline has passed.
+func (c *FakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	c.waiters = slices.DeleteFunc(c.waiters, func(w *fakeWaiter) bool {
+		if c.now.Before(w.until) {
+			return false
+		}
+		close(w.done)
+		return true
+	})
+	c.notify()
+}
+
+// Sleepers returns the number of goroutines blocked in Sleep.
+func (c *FakeClock) Sleepers() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.waiters)
+}
+
+// WaitForSleepers blocks until at least n goroutines are blocked in Sleep or
+// ctx is done. Call it before Advance to be sure the code under test has
+// started waiting.
+func (c *FakeClock) WaitForSleepers(ctx context.Context, n int) error {
+	for {
+		c.mu.Lock()
+		if c.changed == nil {
+			c.changed = make(chan struct{})
+		}
+		count, changed := len(c.waiters), c.changed
+		c.mu.Unlock()
+		if count >= n {
+			return nil
+		}
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// Sleeps returns every duration passed to Sleep so far, in call order.
+func (c *FakeClock) Sleeps() []time.Duration {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return slices.Clone(c.sleeps)
+}
+
+// notify wakes WaitForSleepers callers. c.mu must be held.
+func (c *FakeClock) notify() {
+	if c.changed == nil {
+		return
+	}
+	close(c.changed)
+	c.changed = make(chan struct{})
+}
@@ -0,0 +1,97 @@
+package tavilytest
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily"
+)
+
+func TestFakeClockSleep(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := NewFakeClock(start)
+	ctx := context.Background()
+
+	done := make(chan error, 1)
+	go func() { done <- clock.Sleep(ctx, time.Minute) }()
+
+	if err := clock.WaitForSleepers(ctx, 1); err != nil {
+		t.Fatalf("WaitForSleepers() error = %v", err)
+	}
+	clock.Advance(30 * time.Second)
+	select {
+	case <-done:
+		t.Fatal("Sleep returned before its deadline")
+	default:
+	}
+
+	clock.Advance(30 * time.Second)
+	if err := <-done; err != nil {
+		t.Errorf("Sleep() error = %v", err)
+	}
+	if got := clock.Now(); !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("Now() = %v, want %v", got, start.Add(time.Minute))
+	}
+	if clock.Sleepers() != 0 {
+		t.Errorf("Sleepers() = %d, want 0", clock.Sleepers())
+	}
+}
+
+func TestFakeClockSleepCanceled(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+
+	done := make(chan error, 1)
+	go func() { done <- clock.Sleep(ctx, time.Hour) }()
+	clock.WaitForSleepers(context.Background(), 1)
+	cancel()
+
+	if err := <-done; !errors.Is(err, context.Canceled) {
+		t.Errorf("Sleep() error = %v, want context.Canceled", err)
+	}
+	if clock.Sleepers() != 0 {
+		t.Errorf("Sleepers() = %d, want 0 after cancellation", clock.Sleepers())
+	}
+}
+
+func TestFakeClockDrivesRetries(t *testing.T) {
+	calls := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls < 3 {
+			w.Header().Set("Retry-After", "30")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"query": "q", "results": []}`))
+	}))
+	defer server.Close()
+
+	start := time.Now()
+	clock := NewFakeClock(start)
+	clock.AutoAdvance = true
+	client := tavily.New("tvly-test-key", &tavily.Options{
+		BaseURL: server.URL,
+		Clock:   clock,
+		Retry:   &tavily.RetryPolicy{MaxDelay: time.Minute},
+	})
+
+	if _, err := client.Search(context.Background(), "q", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if want := []time.Duration{30 * time.Second, 30 * time.Second}; !slices.Equal(clock.Sleeps(), want) {
+		t.Errorf("Sleeps() = %v, want %v", clock.Sleeps(), want)
+	}
+	if got := clock.Now().Sub(start); got != time.Minute {
+		t.Errorf("fake time elapsed = %v, want %v", got, time.Minute)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("real time elapsed = %v, want no real sleeps", elapsed)
+	}
+}
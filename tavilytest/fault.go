@@ -0,0 +1,235 @@
+// Package tavilytest provides utilities for testing applications built on go-tavily.
+//
+// FaultTransport injects scripted failures between a tavily.Client and the API
+// (or an httptest server), so retry and circuit-breaker handling can be exercised
+// against realistic Tavily failure modes:
+//
+//	transport := tavilytest.NewFaultTransport(nil,
+//		tavilytest.RateLimit(2, time.Second),
+//		tavilytest.MalformedJSON(),
+//	)
+//	client := tavily.New("tvly-test-key", &tavily.Options{
+//		HTTPClient: &http.Client{Transport: transport},
+//	})
+package tavilytest
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// FaultKind identifies the failure a Fault injects.
+type FaultKind int
+
+const (
+	// FaultPass forwards the request untouched.
+	FaultPass FaultKind = iota
+	// FaultTimeout fails the request with a network timeout error.
+	FaultTimeout
+	// FaultStatus replies with an error status and a Tavily-style error body.
+	FaultStatus
+	// FaultMalformedJSON replies 200 with a body that is not valid JSON.
+	FaultMalformedJSON
+	// FaultTruncatedBody replies 200 with a body cut off mid-stream.
+	FaultTruncatedBody
+)
+
+// Fault is one step of a FaultTransport script.
+type Fault struct {
+	Kind FaultKind
+	// Status is the HTTP status for FaultStatus.
+	Status int
+	// RetryAfter sets the Retry-After header for FaultStatus replies.
+	RetryAfter time.Duration
+	// Delay is waited before the fault is applied, honoring request cancellation.
+	Delay time.Duration
+	// Times repeats the fault for that many consecutive requests. Defaults to 1.
+	Times int
+}
+
+// Pass forwards the next n requests untouched.
+func Pass(n int) Fault {
+	return Fault{Kind: FaultPass, Times: n}
+}
+
+// Timeout fails the next request with a network timeout error after delay.
+func Timeout(delay time.Duration) Fault {
+	return Fault{Kind: FaultTimeout, Delay: delay}
+}
+
+// RateLimit replies 429 to the next n requests with the given Retry-After.
+func RateLimit(n int, retryAfter time.Duration) Fault {
+	return Fault{Kind: FaultStatus, Status: http.StatusTooManyRequests, RetryAfter: retryAfter, Times: n}
+}
+
+// ServerError replies with the given 5xx status to the next request.
+func ServerError(status int) Fault {
+	return Fault{Kind: FaultStatus, Status: status}
+}
+
+// MalformedJSON replies 200 with an invalid JSON body to the next request.
+func MalformedJSON() Fault {
+	return Fault{Kind: FaultMalformedJSON}
+}
+
+// TruncatedBody replies 200 with a body that ends unexpectedly to the next request.
+func TruncatedBody() Fault {
+	return Fault{Kind: FaultTruncatedBody}
+}
+
+// Clock is the time source a FaultTransport waits on. It mirrors tavily.Clock,
+// which this package cannot import, so a FakeClock or the clock given to the
+// client fits both.
+type Clock interface {
+	Now() time.Time
+	Sleep(ctx context.Context, d time.Duration) error
+}
+
+// FaultTransport is an http.RoundTripper that applies a script of faults to
+// successive requests, then forwards all remaining requests to Next.
+// It is safe for concurrent use.
+type FaultTransport struct {
+	// Next handles forwarded requests. Defaults to http.DefaultTransport.
+	Next http.RoundTripper
+	// Clock waits out fault delays. Nil uses the wall clock.
+	Clock Clock
+
+	mu       sync.Mutex
+	script   []Fault
+	requests int
+}
+
+// NewFaultTransport creates a FaultTransport applying faults in order.
+func NewFaultTransport(next http.RoundTripper, faults ...Fault) *FaultTransport {
+	t := &FaultTransport{Next: next}
+	t.Push(faults...)
+	return t
+}
+
+// Push appends faults to the script.
+func (t *FaultTransport) Push(faults ...Fault) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	for _, f := range faults {
+		for range max(f.Times, 1) {
+			step := f
+			step.Times = 1
+			t.script = append(t.script, step)
+		}
+	}
+}
+
+// Requests returns the number of requests seen so far.
+func (t *FaultTransport) Requests() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.requests
+}
+
+// Remaining returns the number of scripted faults not yet applied.
+func (t *FaultTransport) Remaining() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.script)
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FaultTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.mu.Lock()
+	t.requests++
+	fault := Fault{Kind: FaultPass}
+	if len(t.script) > 0 {
+		fault = t.script[0]
+		t.script = t.script[1:]
+	}
+	t.mu.Unlock()
+
+	if fault.Delay > 0 {
+		if err := t.sleep(req.Context(), fault.Delay); err != nil {
+			closeBody(req)
+			return nil, err
+		}
+	}
+	// Injected faults never read the request, but a RoundTripper must close it.
+	if fault.Kind != FaultPass {
+		closeBody(req)
+	}
+
+	switch fault.Kind {
+	case FaultTimeout:
+		return nil, &timeoutError{}
+	case FaultStatus:
+		body := fmt.Sprintf(`{"detail": {"error": %q}}`, http.StatusText(fault.Status))
+		resp := newResponse(req, fault.Status, []byte(body))
+		if fault.RetryAfter > 0 {
+			resp.Header.Set("Retry-After", strconv.Itoa(int(fault.RetryAfter.Round(time.Second)/time.Second)))
+		}
+		return resp, nil
+	case FaultMalformedJSON:
+		return newResponse(req, http.StatusOK, []byte(`{"query": "malformed", "results": [{"url": `)), nil
+	case FaultTruncatedBody:
+		full := []byte(`{"query": "truncated", "response_time": 0.1, "results": []}`)
+		resp := newResponse(req, http.StatusOK, nil)
+		resp.ContentLength = int64(len(full))
+		resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(full[:len(full)/2]), errReader{io.ErrUnexpectedEOF}))
+		return resp, nil
+	}
+
+	next := t.Next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return next.RoundTrip(req)
+}
+
+func (t *FaultTransport) sleep(ctx context.Context, d time.Duration) error {
+	if t.Clock != nil {
+		return t.Clock.Sleep(ctx, d)
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func closeBody(req *http.Request) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+}
+
+func newResponse(req *http.Request, status int, body []byte) *http.Response {
+	return &http.Response{
+		StatusCode:    status,
+		Status:        fmt.Sprintf("%d %s", status, http.StatusText(status)),
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        http.Header{"Content-Type": {"application/json"}},
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+type errReader struct{ err error }
+
+func (r errReader) Read([]byte) (int, error) { return 0, r.err }
+
+// timeoutError mimics the net.Error returned when a connection times out.
+type timeoutError struct{}
+
+func (*timeoutError) Error() string   { return "tavilytest: injected timeout" }
+func (*timeoutError) Timeout() bool   { return true }
+func (*timeoutError) Temporary() bool { return true }
+func (*timeoutError) Unwrap() error   { return context.DeadlineExceeded }
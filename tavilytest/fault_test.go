@@ -0,0 +1,119 @@
+package tavilytest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iamwavecut/go-tavily"
+)
+
+func newClient(t *testing.T, faults ...Fault) (*tavily.Client, *FaultTransport) {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"query": "test", "response_time": 0.1, "results": []}`))
+	}))
+	t.Cleanup(server.Close)
+
+	transport := NewFaultTransport(nil, faults...)
+	client := tavily.New("tvly-test-key", &tavily.Options{
+		BaseURL:    server.URL,
+		HTTPClient: &http.Client{Transport: transport},
+		Retry:      &tavily.RetryPolicy{BaseDelay: time.Millisecond},
+	})
+	return client, transport
+}
+
+func TestFaultTransportRecovers(t *testing.T) {
+	client, transport := newClient(t, Timeout(0), ServerError(http.StatusBadGateway))
+
+	if _, err := client.Search(context.Background(), "test", nil); err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if got := transport.Requests(); got != 3 {
+		t.Errorf("Requests() = %v, want %v", got, 3)
+	}
+}
+
+func TestFaultTransportRateLimitBurst(t *testing.T) {
+	client, transport := newClient(t, RateLimit(3, 0))
+
+	_, err := client.Search(context.Background(), "test", nil)
+	var apiErr *tavily.APIError
+	if !errors.As(err, &apiErr) || !apiErr.IsRateLimit() {
+		t.Fatalf("Search() error = %v, want rate limit *APIError", err)
+	}
+	if transport.Remaining() != 0 {
+		t.Errorf("Remaining() = %v, want 0", transport.Remaining())
+	}
+}
+
+func TestFaultTransportBrokenBodies(t *testing.T) {
+	for name, fault := range map[string]Fault{
+		"malformed JSON": MalformedJSON(),
+		"truncated body": TruncatedBody(),
+	} {
+		t.Run(name, func(t *testing.T) {
+			client, _ := newClient(t, fault)
+			if _, err := client.Search(context.Background(), "test", nil); err == nil {
+				t.Error("Expected error, got nil")
+			}
+		})
+	}
+}
+
+type trackedBody struct {
+	io.Reader
+	closed bool
+}
+
+func (b *trackedBody) Close() error {
+	b.closed = true
+	return nil
+}
+
+func TestFaultTransportClosesBody(t *testing.T) {
+	transport := NewFaultTransport(nil, ServerError(http.StatusBadGateway), Timeout(0))
+	for range 2 {
+		body := &trackedBody{Reader: strings.NewReader(`{"query": "test"}`)}
+		req := httptest.NewRequest(http.MethodPost, "https://api.tavily.com/search", body)
+		resp, _ := transport.RoundTrip(req)
+		if resp != nil {
+			resp.Body.Close()
+		}
+		if !body.closed {
+			t.Errorf("request body not closed after an injected fault")
+		}
+	}
+}
+
+func TestFaultTransportDelayUsesClock(t *testing.T) {
+	clock := NewFakeClock(time.Now())
+	transport := NewFaultTransport(nil, Fault{Kind: FaultStatus, Status: http.StatusServiceUnavailable, Delay: time.Hour})
+	transport.Clock = clock
+
+	done := make(chan *http.Response, 1)
+	go func() {
+		resp, _ := transport.RoundTrip(httptest.NewRequest(http.MethodPost, "https://api.tavily.com/search", nil))
+		done <- resp
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := clock.WaitForSleepers(ctx, 1); err != nil {
+		t.Fatalf("WaitForSleepers() error = %v", err)
+	}
+	clock.Advance(time.Hour)
+	resp := <-done
+	if resp == nil || resp.StatusCode != http.StatusServiceUnavailable {
+		t.Fatalf("RoundTrip() = %v, want the injected 503", resp)
+	}
+	resp.Body.Close()
+}
@@ -0,0 +1,211 @@
+package tavily
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"text/template"
+	"text/template/parse"
+	"time"
+)
+
+// VarKind is the type of a query template variable.
+type VarKind int
+
+const (
+	// VarString accepts string values.
+	VarString VarKind = iota
+	// VarInt accepts any Go integer type.
+	VarInt
+	// VarDate accepts time.Time values, rendered as YYYY-MM-DD.
+	VarDate
+)
+
+func (k VarKind) String() string {
+	switch k {
+	case VarString:
+		return "string"
+	case VarInt:
+		return "int"
+	case VarDate:
+		return "date"
+	default:
+		return fmt.Sprintf("VarKind(%d)", int(k))
+	}
+}
+
+// TemplateVar declares a variable of a query template.
+type TemplateVar struct {
+	Name string
+	Kind VarKind
+	// Optional variables may be omitted and render as their zero value.
+	Optional bool
+}
+
+// QueryTemplates is a registry of named, vetted search phrasings such as
+// "{{.Company}} earnings {{.Quarter}}". Templates are checked against their
+// declared variables when registered, and values are type-checked when
+// rendered. The zero value is ready to use and safe for concurrent use.
+type QueryTemplates struct {
+	mu        sync.RWMutex
+	templates map[string]*queryTemplate
+}
+
+type queryTemplate struct {
+	tmpl *template.Template
+	vars map[string]TemplateVar
+}
+
+// Register parses text as a text/template and stores it under name. It fails if
+// the template references an undeclared variable or leaves a declared one unused.
+func (r *QueryTemplates) Register(name, text string, vars ...TemplateVar) error {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return fmt.Errorf("template %q: %w", name, err)
+	}
+
+	declared := make(map[string]TemplateVar, len(vars))
+	for _, v := range vars {
+		if v.Name == "" {
+			return fmt.Errorf("template %q: variable with empty name", name)
+		}
+		if _, dup := declared[v.Name]; dup {
+			return fmt.Errorf("template %q: variable %q declared twice", name, v.Name)
+		}
+		declared[v.Name] = v
+	}
+
+	used := make(map[string]bool)
+	collectFields(tmpl.Tree.Root, used)
+	for field := range used {
+		if _, ok := declared[field]; !ok {
+			return fmt.Errorf("template %q: references undeclared variable %q", name, field)
+		}
+	}
+	for _, v := range vars {
+		if !used[v.Name] {
+			return fmt.Errorf("template %q: variable %q is declared but not used", name, v.Name)
+		}
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.templates == nil {
+		r.templates = make(map[string]*queryTemplate)
+	}
+	r.templates[name] = &queryTemplate{tmpl: tmpl, vars: declared}
+	return nil
+}
+
+// Names returns the registered template names in sorted order.
+func (r *QueryTemplates) Names() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	names := make([]string, 0, len(r.templates))
+	for name := range r.templates {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Render fills the named template with values and returns the resulting query
+// with whitespace collapsed.
+func (r *QueryTemplates) Render(name string, values map[string]any) (string, error) {
+	r.mu.RLock()
+	t, ok := r.templates[name]
+	r.mu.RUnlock()
+	if !ok {
+		return "", fmt.Errorf("unknown query template %q", name)
+	}
+
+	data := make(map[string]any, len(t.vars))
+	for key := range values {
+		if _, ok := t.vars[key]; !ok {
+			return "", fmt.Errorf("template %q: unknown variable %q", name, key)
+		}
+	}
+	for _, v := range t.vars {
+		value, ok := values[v.Name]
+		if !ok {
+			if !v.Optional {
+				return "", fmt.Errorf("template %q: missing variable %q", name, v.Name)
+			}
+			data[v.Name] = ""
+			continue
+		}
+		rendered, err := renderVar(v, value)
+		if err != nil {
+			return "", fmt.Errorf("template %q: %w", name, err)
+		}
+		data[v.Name] = rendered
+	}
+
+	var b strings.Builder
+	if err := t.tmpl.Execute(&b, data); err != nil {
+		return "", fmt.Errorf("template %q: %w", name, err)
+	}
+	query := strings.Join(strings.Fields(b.String()), " ")
+	if query == "" {
+		return "", fmt.Errorf("template %q rendered an empty query", name)
+	}
+	return query, nil
+}
+
+func renderVar(v TemplateVar, value any) (string, error) {
+	switch v.Kind {
+	case VarString:
+		if s, ok := value.(string); ok {
+			return s, nil
+		}
+	case VarInt:
+		switch value.(type) {
+		case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+			return fmt.Sprint(value), nil
+		}
+	case VarDate:
+		if t, ok := value.(time.Time); ok {
+			return t.Format(time.DateOnly), nil
+		}
+	}
+	return "", fmt.Errorf("variable %q must be %s, got %T", v.Name, v.Kind, value)
+}
+
+// collectFields records the top-level field names (.Name) referenced in node.
+func collectFields(node parse.Node, used map[string]bool) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return
+		}
+		for _, child := range n.Nodes {
+			collectFields(child, used)
+		}
+	case *parse.ActionNode:
+		collectFields(n.Pipe, used)
+	case *parse.PipeNode:
+		if n == nil {
+			return
+		}
+		for _, cmd := range n.Cmds {
+			for _, arg := range cmd.Args {
+				collectFields(arg, used)
+			}
+		}
+	case *parse.FieldNode:
+		used[n.Ident[0]] = true
+	case *parse.IfNode:
+		collectFields(n.Pipe, used)
+		collectFields(n.List, used)
+		collectFields(n.ElseList, used)
+	case *parse.WithNode:
+		collectFields(n.Pipe, used)
+		collectFields(n.List, used)
+		collectFields(n.ElseList, used)
+	case *parse.RangeNode:
+		collectFields(n.Pipe, used)
+		collectFields(n.List, used)
+		collectFields(n.ElseList, used)
+	}
+}
@@ -0,0 +1,80 @@
+package tavily
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestQueryTemplates(t *testing.T) {
+	var templates QueryTemplates
+	err := templates.Register("earnings", "{{.Company}} earnings Q{{.Quarter}} {{if .Since}}after {{.Since}}{{end}}",
+		TemplateVar{Name: "Company", Kind: VarString},
+		TemplateVar{Name: "Quarter", Kind: VarInt},
+		TemplateVar{Name: "Since", Kind: VarDate, Optional: true},
+	)
+	if err != nil {
+		t.Fatalf("Register() error = %v", err)
+	}
+
+	registerTests := []struct {
+		name    string
+		text    string
+		vars    []TemplateVar
+		wantErr string
+	}{
+		{name: "syntax", text: "{{.Company", vars: []TemplateVar{{Name: "Company"}}, wantErr: "unclosed action"},
+		{name: "undeclared", text: "{{.Company}} {{.Year}}", vars: []TemplateVar{{Name: "Company"}}, wantErr: `undeclared variable "Year"`},
+		{name: "unused", text: "{{.Company}}", vars: []TemplateVar{{Name: "Company"}, {Name: "Year"}}, wantErr: `"Year" is declared but not used`},
+	}
+	for _, tt := range registerTests {
+		t.Run("register "+tt.name, func(t *testing.T) {
+			err := templates.Register(tt.name, tt.text, tt.vars...)
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Register() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+
+	renderTests := []struct {
+		name    string
+		values  map[string]any
+		want    string
+		wantErr string
+	}{
+		{
+			name:   "required only",
+			values: map[string]any{"Company": "Acme", "Quarter": 3},
+			want:   "Acme earnings Q3",
+		},
+		{
+			name:   "optional date",
+			values: map[string]any{"Company": "Acme", "Quarter": 3, "Since": time.Date(2025, 1, 2, 0, 0, 0, 0, time.UTC)},
+			want:   "Acme earnings Q3 after 2025-01-02",
+		},
+		{name: "missing", values: map[string]any{"Company": "Acme"}, wantErr: `missing variable "Quarter"`},
+		{name: "wrong type", values: map[string]any{"Company": "Acme", "Quarter": "three"}, wantErr: `"Quarter" must be int, got string`},
+		{name: "unknown", values: map[string]any{"Company": "Acme", "Quarter": 3, "Region": "EU"}, wantErr: `unknown variable "Region"`},
+	}
+	for _, tt := range renderTests {
+		t.Run("render "+tt.name, func(t *testing.T) {
+			got, err := templates.Render("earnings", tt.values)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Errorf("Render() error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Render() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+
+	if names := templates.Names(); len(names) != 1 || names[0] != "earnings" {
+		t.Errorf("Names() = %v, want [earnings]", names)
+	}
+}
@@ -0,0 +1,88 @@
+package tavily
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	day = 24 * time.Hour
+	// year is the longest window a time_range can express.
+	year = 365 * day
+)
+
+// TimeRangeFor returns the narrowest supported time range covering d.
+func TimeRangeFor(d time.Duration) TimeRange {
+	switch {
+	case d <= day:
+		return TimeRangeDay
+	case d <= 7*day:
+		return TimeRangeWeek
+	case d <= 31*day:
+		return TimeRangeMonth
+	default:
+		return TimeRangeYear
+	}
+}
+
+// DaysFor returns the whole number of days covering d, at least 1.
+func DaysFor(d time.Duration) int {
+	days := int((d + day - 1) / day)
+	return max(days, 1)
+}
+
+// resolveTimeWindow converts SearchOptions.Within, Since and Until into the
+// time_range and days parameters, rejecting conflicting settings. News searches
+// use days for finer granularity; other topics use the closest time_range.
+// Since and Until are measured from now.
+func resolveTimeWindow(opts *SearchOptions, now time.Time) (timeRange string, days int, err error) {
+	timeRange, days = opts.TimeRange, opts.Days
+	if days == Unset {
+		days = 0
+	}
+
+	if timeRange != "" && !TimeRange(timeRange).IsValid() {
+		return "", 0, fmt.Errorf("unknown time range %q", timeRange)
+	}
+
+	typed := opts.Within != 0 || !opts.Since.IsZero() || !opts.Until.IsZero()
+	if !typed {
+		return timeRange, days, nil
+	}
+
+	switch {
+	case timeRange != "" || days != 0:
+		return "", 0, fmt.Errorf("Within/Since/Until cannot be combined with TimeRange or Days")
+	case opts.Within != 0 && !opts.Since.IsZero():
+		return "", 0, fmt.Errorf("Within and Since are mutually exclusive")
+	case opts.Within < 0:
+		return "", 0, fmt.Errorf("Within must be positive, got %v", opts.Within)
+	}
+
+	if !opts.Until.IsZero() {
+		if !opts.Since.IsZero() && opts.Until.Before(opts.Since) {
+			return "", 0, fmt.Errorf("Until (%s) is before Since (%s)",
+				opts.Until.Format(time.RFC3339), opts.Since.Format(time.RFC3339))
+		}
+		// time_range and days always end now, so an earlier end cannot be expressed.
+		if now.Sub(opts.Until) > day {
+			return "", 0, fmt.Errorf("Until (%s) must be within the last day", opts.Until.Format(time.RFC3339))
+		}
+	}
+
+	window := opts.Within
+	if !opts.Since.IsZero() {
+		window = now.Sub(opts.Since)
+		if window <= 0 {
+			return "", 0, fmt.Errorf("Since (%s) is in the future", opts.Since.Format(time.RFC3339))
+		}
+	}
+	if window == 0 {
+		return "", 0, nil
+	}
+
+	if opts.Topic == string(TopicNews) {
+		return "", DaysFor(window), nil
+	}
+	return string(TimeRangeFor(window)), 0, nil
+}
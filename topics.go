@@ -0,0 +1,88 @@
+package tavily
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// SearchAcrossTopics runs the same query against several topics in parallel and
+// merges the results into one blended response ordered by score. Each result is
+// tagged with the topic that returned it; URLs returned by several topics keep the
+// highest-scoring copy. With no topics given, general, news and finance are queried.
+func (c *Client) SearchAcrossTopics(ctx context.Context, query string, opts *SearchOptions, topics ...Topic) (*SearchResponse, error) {
+	if len(topics) == 0 {
+		topics = []Topic{TopicGeneral, TopicNews, TopicFinance}
+	}
+
+	if opts == nil {
+		opts = &SearchOptions{}
+	}
+
+	responses := make([]*SearchResponse, len(topics))
+	errs := make([]error, len(topics))
+
+	var wg sync.WaitGroup
+	for i, topic := range topics {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			topicOpts := *opts
+			topicOpts.Topic = string(topic)
+			responses[i], errs[i] = c.Search(ctx, query, &topicOpts)
+		}()
+	}
+	wg.Wait()
+
+	for i, err := range errs {
+		if err != nil {
+			return nil, fmt.Errorf("topic %s: %w", topics[i], err)
+		}
+	}
+
+	return mergeTaggedResponses(query, responses, func(i int, r *SearchResult) { r.Topic = topics[i] }), nil
+}
+
+// mergeTaggedResponses blends parallel responses to one query into one ordered
+// by score, calling tag on every result of responses[i]. URLs returned more
+// than once keep the highest-scoring copy.
+func mergeTaggedResponses(query string, responses []*SearchResponse, tag func(i int, r *SearchResult)) *SearchResponse {
+	merged := &SearchResponse{Query: query}
+	index := make(map[string]int)
+
+	merged.Meta.CacheHit = true
+	for i, resp := range responses {
+		merged.ResponseTime = max(merged.ResponseTime, resp.ResponseTime)
+		// Calls run in parallel, so wall clock is the slowest one, not the sum.
+		elapsed := max(merged.Meta.ElapsedWallClock, resp.Meta.ElapsedWallClock)
+		merged.Meta.add(resp.Meta)
+		merged.Meta.ElapsedWallClock = elapsed
+		if merged.Answer == "" {
+			merged.Answer = resp.Answer
+		}
+		for _, img := range resp.Images {
+			if !slices.Contains(merged.Images, img) {
+				merged.Images = append(merged.Images, img)
+			}
+		}
+
+		for _, r := range resp.Results {
+			tag(i, &r)
+			if j, ok := index[r.URL]; ok {
+				if r.Score > merged.Results[j].Score {
+					merged.Results[j] = r
+				}
+				continue
+			}
+			index[r.URL] = len(merged.Results)
+			merged.Results = append(merged.Results, r)
+		}
+	}
+
+	slices.SortStableFunc(merged.Results, func(a, b SearchResult) int {
+		return cmp.Compare(b.Score, a.Score)
+	})
+	return merged
+}
@@ -0,0 +1,113 @@
+package tavily
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// TruncatePolicy selects which part of an over-long text Truncate keeps.
+type TruncatePolicy string
+
+const (
+	// TruncateHead keeps the beginning of the text.
+	TruncateHead TruncatePolicy = "head"
+	// TruncateTail keeps the end of the text.
+	TruncateTail TruncatePolicy = "tail"
+	// TruncateMiddle keeps the beginning and the end, dropping the middle.
+	TruncateMiddle TruncatePolicy = "middle"
+	// TruncateSentence keeps the beginning up to the last complete sentence
+	// that fits, falling back to TruncateHead when none does.
+	TruncateSentence TruncatePolicy = "sentence"
+)
+
+// DefaultTruncateMarker replaces the text Truncate removes.
+const DefaultTruncateMarker = " […] "
+
+// bytesPerToken approximates the size of a token for TruncateOptions.MaxTokens.
+const bytesPerToken = 4
+
+// TruncateOptions caps text such as a tool result before it is handed to an
+// LLM. The stricter of MaxBytes and MaxTokens applies; zero fields are
+// unlimited.
+type TruncateOptions struct {
+	// Policy defaults to TruncateHead.
+	Policy TruncatePolicy
+	// MaxBytes caps the size of the result, marker included.
+	MaxBytes int
+	// MaxTokens caps the estimated token count of the result, at four bytes
+	// per token.
+	MaxTokens int
+	// Marker replaces the removed text. Empty uses DefaultTruncateMarker; it is
+	// left out when it does not fit the cap.
+	Marker string
+}
+
+// limit returns the byte cap, or 0 if there is none.
+func (o TruncateOptions) limit() int {
+	limit := o.MaxBytes
+	if tokens := o.MaxTokens * bytesPerToken; tokens > 0 && (limit <= 0 || tokens < limit) {
+		limit = tokens
+	}
+	return max(limit, 0)
+}
+
+// Truncate shortens text to the cap in opts according to its Policy, never
+// splitting a UTF-8 sequence, and reports whether anything was removed.
+func Truncate(text string, opts TruncateOptions) (string, bool) {
+	limit := opts.limit()
+	if limit == 0 || len(text) <= limit {
+		return text, false
+	}
+
+	marker := defaultString(opts.Marker, DefaultTruncateMarker)
+	if len(marker) >= limit {
+		marker = ""
+	}
+	keep := limit - len(marker)
+
+	switch opts.Policy {
+	case TruncateTail:
+		return marker + text[tailStart(text, keep):], true
+	case TruncateMiddle:
+		head := headEnd(text, keep-keep/2)
+		return text[:head] + marker + text[tailStart(text, keep/2):], true
+	case TruncateSentence:
+		end := lastSentenceEnd(text, keep)
+		if end == 0 {
+			end = headEnd(text, keep)
+		}
+		return text[:end] + marker, true
+	default:
+		return text[:headEnd(text, keep)] + marker, true
+	}
+}
+
+// headEnd returns the largest index up to n that starts a UTF-8 sequence.
+func headEnd(text string, n int) int {
+	for n > 0 && n < len(text) && !utf8.RuneStart(text[n]) {
+		n--
+	}
+	return n
+}
+
+// tailStart returns the start of the last n bytes of text, moved forward to
+// the next UTF-8 sequence.
+func tailStart(text string, n int) int {
+	i := len(text) - n
+	for i < len(text) && !utf8.RuneStart(text[i]) {
+		i++
+	}
+	return i
+}
+
+// lastSentenceEnd returns the index, at most n, just past the last
+// sentence-ending punctuation in text followed by whitespace, or 0 if there
+// is none. text must be longer than n.
+func lastSentenceEnd(text string, n int) int {
+	for i := n - 1; i >= 0; i-- {
+		if strings.IndexByte(".!?", text[i]) >= 0 && strings.IndexByte(" \t\n\r", text[i+1]) >= 0 {
+			return i + 1
+		}
+	}
+	return 0
+}
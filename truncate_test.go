@@ -0,0 +1,51 @@
+package tavily
+
+import (
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	const text = "First sentence. Second one! Third?"
+
+	tests := []struct {
+		name          string
+		text          string
+		opts          TruncateOptions
+		want          string
+		wantTruncated bool
+	}{
+		{"fits", text, TruncateOptions{MaxBytes: 100}, text, false},
+		{"unlimited", text, TruncateOptions{}, text, false},
+		{"head", text, TruncateOptions{MaxBytes: 12, Marker: "…"}, "First sen…", true},
+		{"tail", text, TruncateOptions{Policy: TruncateTail, MaxBytes: 10, Marker: "|"}, "|e! Third?", true},
+		{"middle", text, TruncateOptions{Policy: TruncateMiddle, MaxBytes: 11, Marker: "|"}, "First|hird?", true},
+		{"sentence", text, TruncateOptions{Policy: TruncateSentence, MaxBytes: 30, Marker: "|"}, "First sentence. Second one!|", true},
+		{"sentence fallback", text, TruncateOptions{Policy: TruncateSentence, MaxBytes: 8, Marker: "|"}, "First s|", true},
+		{"tokens", text, TruncateOptions{MaxTokens: 2, Marker: "|"}, "First s|", true},
+		{"stricter cap wins", text, TruncateOptions{MaxBytes: 6, MaxTokens: 2, Marker: "|"}, "First|", true},
+		{"marker too long", text, TruncateOptions{MaxBytes: 3, Marker: "[truncated]"}, "Fir", true},
+		{"default marker", text, TruncateOptions{MaxBytes: 13}, "First " + DefaultTruncateMarker, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, truncated := Truncate(tt.text, tt.opts)
+			if got != tt.want || truncated != tt.wantTruncated {
+				t.Errorf("Truncate() = %q, %v, want %q, %v", got, truncated, tt.want, tt.wantTruncated)
+			}
+		})
+	}
+}
+
+func TestTruncateUTF8(t *testing.T) {
+	const text = "日本語のテキストです"
+	for _, policy := range []TruncatePolicy{TruncateHead, TruncateTail, TruncateMiddle, TruncateSentence} {
+		for limit := 1; limit < len(text); limit++ {
+			got, _ := Truncate(text, TruncateOptions{Policy: policy, MaxBytes: limit, Marker: "|"})
+			if !utf8.ValidString(got) || len(got) > limit {
+				t.Errorf("Truncate(%v, %d) = %q, want valid UTF-8 within the cap", policy, limit, got)
+			}
+		}
+	}
+}
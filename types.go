@@ -1,12 +1,21 @@
 package tavily
 
+import (
+	"regexp"
+	"time"
+)
+
 // APIError represents an error response from the Tavily API.
 type APIError struct {
 	StatusCode int
 	Message    string
+	RequestID  string
 }
 
 func (e *APIError) Error() string {
+	if e.RequestID != "" {
+		return e.Message + " (request id: " + e.RequestID + ")"
+	}
 	return e.Message
 }
 
@@ -15,9 +24,41 @@ func (e *APIError) IsRateLimit() bool {
 	return e.StatusCode == 429
 }
 
-// IsForbidden returns true if the error is due to access denied or usage limit exceeded.
+// IsForbidden returns true if the API refused the request with 403, either
+// because the key is disabled or it lacks access. Usage limits are reported
+// by IsPlanLimitExceeded instead.
 func (e *APIError) IsForbidden() bool {
-	return e.StatusCode == 403 || e.StatusCode == 432 || e.StatusCode == 433
+	return e.StatusCode == 403
+}
+
+var (
+	keyDisabledText = regexp.MustCompile(`(?i)\b(?:disabled|deactivated|revoked|suspended)\b`)
+	usageLimitText  = regexp.MustCompile(`(?i)usage limit|credit limit|out of credits`)
+)
+
+// IsPlanLimitExceeded returns true if the request exceeds the plan's usage
+// limit (432) or the pay-as-you-go limit (433), a billing rather than a
+// permission problem.
+func (e *APIError) IsPlanLimitExceeded() bool {
+	return e.StatusCode == 432 || e.StatusCode == 433 ||
+		e.StatusCode == 403 && usageLimitText.MatchString(e.Message)
+}
+
+// IsPayAsYouGoLimitExceeded returns true if the request exceeds the
+// pay-as-you-go spending limit (433).
+func (e *APIError) IsPayAsYouGoLimitExceeded() bool {
+	return e.StatusCode == 433
+}
+
+// IsKeyDisabled returns true if the API key was disabled, deactivated or revoked.
+func (e *APIError) IsKeyDisabled() bool {
+	return (e.StatusCode == 401 || e.StatusCode == 403) && keyDisabledText.MatchString(e.Message)
+}
+
+// IsAccessDenied returns true if the key is valid and within its limits but
+// may not perform the request.
+func (e *APIError) IsAccessDenied() bool {
+	return e.StatusCode == 403 && !e.IsKeyDisabled() && !e.IsPlanLimitExceeded()
 }
 
 // IsUnauthorized returns true if the error is due to invalid API key.
@@ -38,6 +79,14 @@ const (
 	SearchDepthAdvanced SearchDepth = "advanced"
 )
 
+// ExtractDepth represents the depth level for extract and crawl operations.
+type ExtractDepth string
+
+const (
+	ExtractDepthBasic    ExtractDepth = "basic"
+	ExtractDepthAdvanced ExtractDepth = "advanced"
+)
+
 // Topic represents the topic category for search operations.
 type Topic string
 
@@ -99,62 +148,113 @@ const (
 
 // SearchOptions contains optional parameters for search requests.
 type SearchOptions struct {
-	SearchDepth              string
-	Topic                    string
-	TimeRange                string
-	Days                     int
-	MaxResults               int
-	IncludeDomains           []string
-	ExcludeDomains           []string
-	IncludeAnswer            any
-	IncludeRawContent        any
-	IncludeImages            *bool
-	IncludeImageDescriptions *bool
-	MaxTokens                int
-	ChunksPerSource          int
-	Country                  string
-	Timeout                  int
+	SearchDepth              string   `json:"search_depth,omitempty" yaml:"search_depth,omitempty"`
+	Topic                    string   `json:"topic,omitempty" yaml:"topic,omitempty"`
+	TimeRange                string   `json:"time_range,omitempty" yaml:"time_range,omitempty"`
+	Days                     int      `json:"days,omitempty" yaml:"days,omitempty"`
+	MaxResults               int      `json:"max_results,omitempty" yaml:"max_results,omitempty"`
+	IncludeDomains           []string `json:"include_domains,omitempty" yaml:"include_domains,omitempty"`
+	ExcludeDomains           []string `json:"exclude_domains,omitempty" yaml:"exclude_domains,omitempty"`
+	IncludeAnswer            any      `json:"include_answer,omitempty" yaml:"include_answer,omitempty"`
+	IncludeRawContent        any      `json:"include_raw_content,omitempty" yaml:"include_raw_content,omitempty"`
+	IncludeImages            *bool    `json:"include_images,omitempty" yaml:"include_images,omitempty"`
+	IncludeImageDescriptions *bool    `json:"include_image_descriptions,omitempty" yaml:"include_image_descriptions,omitempty"`
+	MaxTokens                int      `json:"max_tokens,omitempty" yaml:"max_tokens,omitempty"`
+	ChunksPerSource          int      `json:"chunks_per_source,omitempty" yaml:"chunks_per_source,omitempty"`
+	Country                  string   `json:"country,omitempty" yaml:"country,omitempty"`
+	Timeout                  int      `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// Within limits results to the given look-back window, converted to the
+	// closest supported TimeRange (or Days for news).
+	Within time.Duration `json:"within,omitempty" yaml:"within,omitempty"`
+	// Since limits results to those published after it; Until optionally bounds
+	// the window and must be within the last day. Converted like Within.
+	Since time.Time `json:"since,omitzero" yaml:"since,omitempty"`
+	Until time.Time `json:"until,omitzero" yaml:"until,omitempty"`
+	// MaxResultsPerDomain caps results from a single host, applied client-side.
+	// If the cap leaves fewer than MaxResults results, one follow-up search
+	// excluding the capped domains is issued to fill the list.
+	MaxResultsPerDomain int `json:"max_results_per_domain,omitempty" yaml:"max_results_per_domain,omitempty"`
+	// MinScore drops results scoring below it, applied client-side.
+	MinScore float64 `json:"min_score,omitempty" yaml:"min_score,omitempty"`
+	// DomainScorer rates result domains; results from domains scoring below
+	// MinDomainScore are dropped, applied client-side.
+	DomainScorer   DomainScorer `json:"-" yaml:"-"`
+	MinDomainScore float64      `json:"min_domain_score,omitempty" yaml:"min_domain_score,omitempty"`
+	// Dedup drops results whose URLs differ only cosmetically (scheme, "www.", trailing slash).
+	Dedup bool `json:"dedup,omitempty" yaml:"dedup,omitempty"`
+	// FillResults issues a follow-up search excluding every domain already seen
+	// when client-side filters leave fewer than MaxResults results.
+	FillResults bool `json:"fill_results,omitempty" yaml:"fill_results,omitempty"`
+	// RawContentPolicy decides what happens when raw content arrives in a
+	// different format than IncludeRawContent requested.
+	RawContentPolicy FormatPolicy `json:"raw_content_policy,omitempty" yaml:"raw_content_policy,omitempty"`
+	// Enrich runs a local pass filling SearchResult.Metadata for every result.
+	Enrich *EnrichOptions `json:"enrich,omitempty" yaml:"enrich,omitempty"`
+	// VerifyLinks checks result URLs locally, with the given concurrency and
+	// timeout, and drops results answering 404 or 410 or whose host does not
+	// exist. Timeouts and other network errors keep the result. It fills
+	// Metadata like Enrich, so the two cannot both be set.
+	VerifyLinks *EnrichOptions `json:"verify_links,omitempty" yaml:"verify_links,omitempty"`
 }
 
 // ExtractOptions contains optional parameters for extract requests.
 type ExtractOptions struct {
-	IncludeImages *bool
-	ExtractDepth  string
-	Format        string
-	Timeout       int
+	IncludeImages *bool  `json:"include_images,omitempty" yaml:"include_images,omitempty"`
+	ExtractDepth  string `json:"extract_depth,omitempty" yaml:"extract_depth,omitempty"`
+	Format        string `json:"format,omitempty" yaml:"format,omitempty"`
+	Timeout       int    `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+
+	// LocalFallback fetches URLs listed in failed_results from this machine and
+	// extracts them with the given extractor, e.g. BasicExtractor{}.
+	LocalFallback ContentExtractor `json:"-" yaml:"-"`
+	// ResolveRedirects follows redirect chains locally before sending URLs, so
+	// shortened links are extracted once under their final address. The
+	// mapping is reported in ExtractResponse.Redirects.
+	ResolveRedirects bool `json:"resolve_redirects,omitempty" yaml:"resolve_redirects,omitempty"`
+	// Binary decides how URLs detected as binary files (archives, media,
+	// executables) are handled. PDFs are supported upstream and always sent.
+	Binary BinaryPolicy `json:"binary,omitempty" yaml:"binary,omitempty"`
+	// Attribution makes ExtractToWriter attach an Attribution to every document.
+	Attribution *AttributionPolicy `json:"attribution,omitempty" yaml:"attribution,omitempty"`
+	// HealthCheck checks URLs from this machine before sending them, see
+	// CheckURLHealth, and leaves out dead, oversized and binary targets. They
+	// are reported in ExtractResponse.Unhealthy.
+	HealthCheck *HealthCheckOptions `json:"health_check,omitempty" yaml:"health_check,omitempty"`
 }
 
 // CrawlOptions contains optional parameters for crawl requests.
 type CrawlOptions struct {
-	MaxDepth       int
-	MaxBreadth     int
-	Limit          int
-	Instructions   string
-	ExtractDepth   string
-	SelectPaths    []string
-	SelectDomains  []string
-	ExcludePaths   []string
-	ExcludeDomains []string
-	AllowExternal  *bool
-	IncludeImages  *bool
-	Categories     []CrawlCategory
-	Format         string
-	Timeout        int
+	MaxDepth       int             `json:"max_depth,omitempty" yaml:"max_depth,omitempty"`
+	MaxBreadth     int             `json:"max_breadth,omitempty" yaml:"max_breadth,omitempty"`
+	Limit          int             `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Instructions   string          `json:"instructions,omitempty" yaml:"instructions,omitempty"`
+	ExtractDepth   string          `json:"extract_depth,omitempty" yaml:"extract_depth,omitempty"`
+	SelectPaths    []string        `json:"select_paths,omitempty" yaml:"select_paths,omitempty"`
+	SelectDomains  []string        `json:"select_domains,omitempty" yaml:"select_domains,omitempty"`
+	ExcludePaths   []string        `json:"exclude_paths,omitempty" yaml:"exclude_paths,omitempty"`
+	ExcludeDomains []string        `json:"exclude_domains,omitempty" yaml:"exclude_domains,omitempty"`
+	AllowExternal  *bool           `json:"allow_external,omitempty" yaml:"allow_external,omitempty"`
+	IncludeImages  *bool           `json:"include_images,omitempty" yaml:"include_images,omitempty"`
+	Categories     []CrawlCategory `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Format         string          `json:"format,omitempty" yaml:"format,omitempty"`
+	Timeout        int             `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	Preflight      PreflightPolicy `json:"preflight,omitempty" yaml:"preflight,omitempty"`
 }
 
 // MapOptions contains optional parameters for map requests.
 type MapOptions struct {
-	MaxDepth       int
-	MaxBreadth     int
-	Limit          int
-	Instructions   string
-	SelectPaths    []string
-	SelectDomains  []string
-	ExcludePaths   []string
-	ExcludeDomains []string
-	AllowExternal  *bool
-	Categories     []CrawlCategory
-	Timeout        int
+	MaxDepth       int             `json:"max_depth,omitempty" yaml:"max_depth,omitempty"`
+	MaxBreadth     int             `json:"max_breadth,omitempty" yaml:"max_breadth,omitempty"`
+	Limit          int             `json:"limit,omitempty" yaml:"limit,omitempty"`
+	Instructions   string          `json:"instructions,omitempty" yaml:"instructions,omitempty"`
+	SelectPaths    []string        `json:"select_paths,omitempty" yaml:"select_paths,omitempty"`
+	SelectDomains  []string        `json:"select_domains,omitempty" yaml:"select_domains,omitempty"`
+	ExcludePaths   []string        `json:"exclude_paths,omitempty" yaml:"exclude_paths,omitempty"`
+	ExcludeDomains []string        `json:"exclude_domains,omitempty" yaml:"exclude_domains,omitempty"`
+	AllowExternal  *bool           `json:"allow_external,omitempty" yaml:"allow_external,omitempty"`
+	Categories     []CrawlCategory `json:"categories,omitempty" yaml:"categories,omitempty"`
+	Timeout        int             `json:"timeout,omitempty" yaml:"timeout,omitempty"`
 }
 
 // SearchRequest represents the request payload for search operations.
@@ -163,8 +263,8 @@ type SearchRequest struct {
 	SearchDepth              string   `json:"search_depth,omitempty"`
 	Topic                    string   `json:"topic,omitempty"`
 	TimeRange                string   `json:"time_range,omitempty"`
-	Days                     int      `json:"days,omitempty"`
-	MaxResults               int      `json:"max_results,omitempty"`
+	Days                     *int     `json:"days,omitempty"`
+	MaxResults               *int     `json:"max_results,omitempty"`
 	IncludeDomains           []string `json:"include_domains,omitempty"`
 	ExcludeDomains           []string `json:"exclude_domains,omitempty"`
 	IncludeAnswer            any      `json:"include_answer,omitempty"`
@@ -174,7 +274,7 @@ type SearchRequest struct {
 	MaxTokens                int      `json:"max_tokens,omitempty"`
 	ChunksPerSource          int      `json:"chunks_per_source,omitempty"`
 	Country                  string   `json:"country,omitempty"`
-	Timeout                  int      `json:"timeout,omitempty"`
+	Timeout                  *int     `json:"timeout,omitempty"`
 }
 
 // ExtractRequest represents the request payload for extract operations.
@@ -183,15 +283,15 @@ type ExtractRequest struct {
 	IncludeImages *bool    `json:"include_images,omitempty"`
 	ExtractDepth  string   `json:"extract_depth,omitempty"`
 	Format        string   `json:"format,omitempty"`
-	Timeout       int      `json:"timeout,omitempty"`
+	Timeout       *int     `json:"timeout,omitempty"`
 }
 
 // CrawlRequest represents the request payload for crawl operations.
 type CrawlRequest struct {
 	URL            string          `json:"url"`
-	MaxDepth       int             `json:"max_depth,omitempty"`
-	MaxBreadth     int             `json:"max_breadth,omitempty"`
-	Limit          int             `json:"limit,omitempty"`
+	MaxDepth       *int            `json:"max_depth,omitempty"`
+	MaxBreadth     *int            `json:"max_breadth,omitempty"`
+	Limit          *int            `json:"limit,omitempty"`
 	Instructions   string          `json:"instructions,omitempty"`
 	ExtractDepth   string          `json:"extract_depth,omitempty"`
 	SelectPaths    []string        `json:"select_paths,omitempty"`
@@ -202,15 +302,15 @@ type CrawlRequest struct {
 	IncludeImages  *bool           `json:"include_images,omitempty"`
 	Categories     []CrawlCategory `json:"categories,omitempty"`
 	Format         string          `json:"format,omitempty"`
-	Timeout        int             `json:"timeout,omitempty"`
+	Timeout        *int            `json:"timeout,omitempty"`
 }
 
 // MapRequest represents the request payload for map operations.
 type MapRequest struct {
 	URL            string          `json:"url"`
-	MaxDepth       int             `json:"max_depth,omitempty"`
-	MaxBreadth     int             `json:"max_breadth,omitempty"`
-	Limit          int             `json:"limit,omitempty"`
+	MaxDepth       *int            `json:"max_depth,omitempty"`
+	MaxBreadth     *int            `json:"max_breadth,omitempty"`
+	Limit          *int            `json:"limit,omitempty"`
 	Instructions   string          `json:"instructions,omitempty"`
 	SelectPaths    []string        `json:"select_paths,omitempty"`
 	SelectDomains  []string        `json:"select_domains,omitempty"`
@@ -218,7 +318,7 @@ type MapRequest struct {
 	ExcludeDomains []string        `json:"exclude_domains,omitempty"`
 	AllowExternal  *bool           `json:"allow_external,omitempty"`
 	Categories     []CrawlCategory `json:"categories,omitempty"`
-	Timeout        int             `json:"timeout,omitempty"`
+	Timeout        *int            `json:"timeout,omitempty"`
 }
 
 // SearchResult represents a single search result.
@@ -229,6 +329,15 @@ type SearchResult struct {
 	RawContent    string  `json:"raw_content,omitempty"`
 	Score         float64 `json:"score"`
 	PublishedDate string  `json:"published_date,omitempty"`
+
+	// Topic is set by SearchAcrossTopics to the topic that returned the result.
+	Topic Topic `json:"topic,omitempty"`
+	// Language is set by SearchInLanguages to the language whose query returned the result.
+	Language string `json:"language,omitempty"`
+	// Metadata is filled in by the local enrichment pass.
+	Metadata *ResultMetadata `json:"metadata,omitempty"`
+	// Provenance identifies the retrieval call that returned the result.
+	Provenance *Provenance `json:"provenance,omitempty"`
 }
 
 // SearchResponse represents the response from search operations.
@@ -238,6 +347,15 @@ type SearchResponse struct {
 	ResponseTime float64        `json:"response_time"`
 	Images       []string       `json:"images"`
 	Results      []SearchResult `json:"results"`
+
+	// ImageDetails pairs each image with its description when
+	// IncludeImageDescriptions was set; Images still lists the URLs.
+	ImageDetails []SearchImage `json:"-"`
+
+	// FilteredContent lists what Options.ContentFilter withheld.
+	FilteredContent []FilteredContent `json:"-"`
+
+	Meta ResponseMeta `json:"-"`
 }
 
 // ExtractResult represents a successful content extraction.
@@ -245,12 +363,20 @@ type ExtractResult struct {
 	URL        string   `json:"url"`
 	RawContent string   `json:"raw_content"`
 	Images     []string `json:"images,omitempty"`
+
+	// LocalFallback reports whether the content was extracted locally after the API failed.
+	LocalFallback bool `json:"-"`
 }
 
 // ExtractFailedResult represents a failed content extraction.
 type ExtractFailedResult struct {
 	URL   string `json:"url"`
 	Error string `json:"error"`
+
+	// Reason is the failure cause parsed from Error.
+	Reason FailureReason `json:"-"`
+	// SkipReason is set when the URL was never sent to the API, see ExtractOptions.Binary.
+	SkipReason string `json:"-"`
 }
 
 // ExtractResponse represents the response from extract operations.
@@ -258,6 +384,15 @@ type ExtractResponse struct {
 	ResponseTime  float64               `json:"response_time"`
 	Results       []ExtractResult       `json:"results"`
 	FailedResults []ExtractFailedResult `json:"failed_results"`
+
+	// Redirects maps requested URLs to the final URLs they were extracted
+	// under when ExtractOptions.ResolveRedirects is set.
+	Redirects map[string]string `json:"-"`
+	// Unhealthy lists URLs left out by ExtractOptions.HealthCheck, kept apart
+	// from the API's FailedResults.
+	Unhealthy []ExtractFailedResult `json:"-"`
+
+	Meta ResponseMeta `json:"-"`
 }
 
 // CrawlResult represents a crawled page with content.
@@ -272,6 +407,12 @@ type CrawlResponse struct {
 	ResponseTime float64       `json:"response_time"`
 	BaseURL      string        `json:"base_url"`
 	Results      []CrawlResult `json:"results"`
+
+	// PreflightWarnings holds problems found by the local pre-flight check when
+	// CrawlOptions.Preflight is PreflightWarn.
+	PreflightWarnings []string `json:"-"`
+
+	Meta ResponseMeta `json:"-"`
 }
 
 // MapResponse represents the response from map operations.
@@ -279,4 +420,6 @@ type MapResponse struct {
 	ResponseTime float64  `json:"response_time"`
 	BaseURL      string   `json:"base_url"`
 	Results      []string `json:"results"`
+
+	Meta ResponseMeta `json:"-"`
 }
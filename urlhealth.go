@@ -0,0 +1,135 @@
+package tavily
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// DefaultHealthMaxBytes is the Content-Length above which a URL is treated as
+// too large to extract.
+const DefaultHealthMaxBytes = 20 << 20
+
+// HealthCheckOptions controls the URL health check run before Extract.
+type HealthCheckOptions struct {
+	// Concurrency caps parallel requests. Defaults to DefaultEnrichConcurrency.
+	Concurrency int `json:"concurrency,omitempty" yaml:"concurrency,omitempty"`
+	// Timeout bounds each URL check. Defaults to DefaultEnrichTimeout.
+	Timeout time.Duration `json:"timeout,omitempty" yaml:"timeout,omitempty"`
+	// MaxBytes is the largest advertised Content-Length accepted. Defaults to
+	// DefaultHealthMaxBytes; a negative value disables the limit.
+	MaxBytes int64 `json:"max_bytes,omitempty" yaml:"max_bytes,omitempty"`
+}
+
+// binaryMediaTypes are application/* content types the extract endpoint cannot
+// turn into text; image, audio, video and font types are always binary.
+var binaryMediaTypes = map[string]bool{
+	"application/octet-stream": true, "application/zip": true, "application/gzip": true,
+	"application/x-gzip": true, "application/x-tar": true, "application/x-7z-compressed": true,
+	"application/x-rar-compressed": true, "application/vnd.rar": true, "application/x-msdownload": true,
+	"application/x-iso9660-image": true, "application/java-archive": true, "application/wasm": true,
+	"application/vnd.android.package-archive": true, "application/x-apple-diskimage": true,
+}
+
+func isBinaryMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	for _, prefix := range []string{"image/", "audio/", "video/", "font/"} {
+		if strings.HasPrefix(mediaType, prefix) {
+			return true
+		}
+	}
+	return binaryMediaTypes[mediaType]
+}
+
+// CheckURLHealth HEAD-checks urls from this machine in parallel, falling back
+// to GET where HEAD is not allowed, and splits them into the URLs worth
+// extracting and failures for obvious dead (unreachable, 404 or 410),
+// oversized and binary targets. No Tavily credits are spent. Checks that time
+// out or are cut short by ctx leave their URL in the healthy list.
+func (c *Client) CheckURLHealth(ctx context.Context, urls []string, opts *HealthCheckOptions) (healthy []string, unhealthy []ExtractFailedResult) {
+	ctx, done, err := c.lifecycle.begin(ctx)
+	if err != nil {
+		return urls, nil
+	}
+	defer done()
+
+	if opts == nil {
+		opts = &HealthCheckOptions{}
+	}
+	concurrency := defaultInt(opts.Concurrency, DefaultEnrichConcurrency)
+	timeout := opts.Timeout
+	if timeout == 0 {
+		timeout = DefaultEnrichTimeout
+	}
+	maxBytes := opts.MaxBytes
+	if maxBytes == 0 {
+		maxBytes = DefaultHealthMaxBytes
+	}
+
+	failures := make([]*ExtractFailedResult, len(urls))
+	sem := make(chan struct{}, concurrency)
+	var wg sync.WaitGroup
+	for i, u := range urls {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+			case <-ctx.Done():
+				return
+			}
+			defer func() { <-sem }()
+
+			checkCtx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			failures[i] = c.checkURLHealth(ctx, checkCtx, u, maxBytes)
+		}()
+	}
+	wg.Wait()
+
+	for i, u := range urls {
+		if failures[i] == nil {
+			healthy = append(healthy, u)
+		} else {
+			unhealthy = append(unhealthy, *failures[i])
+		}
+	}
+	return healthy, unhealthy
+}
+
+// checkURLHealth returns a failure for rawURL, or nil when it looks
+// extractable or the check ended before it could tell.
+func (c *Client) checkURLHealth(ctx, checkCtx context.Context, rawURL string, maxBytes int64) *ExtractFailedResult {
+	skip := func(reason FailureReason, format string, args ...any) *ExtractFailedResult {
+		message := fmt.Sprintf(format, args...)
+		return &ExtractFailedResult{URL: rawURL, Error: "skipped: " + message, Reason: reason, SkipReason: message}
+	}
+
+	resp, err := c.probeHeadOrGet(checkCtx, rawURL)
+	switch {
+	case err != nil && (ctx.Err() != nil || errors.Is(err, context.DeadlineExceeded)):
+		// A slow host is not obviously dead; the API may still reach it.
+		return nil
+	case err != nil:
+		return skip(ReasonUnknown, "unreachable: %v", err)
+	}
+	resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return skip(ReasonNotFound, "health check returned status %d", resp.StatusCode)
+	case maxBytes > 0 && resp.ContentLength > maxBytes:
+		return skip(ReasonTooLarge, "content length %d exceeds %d bytes", resp.ContentLength, maxBytes)
+	case isBinaryMediaType(resp.Header.Get("Content-Type")):
+		return skip(ReasonUnsupported, "binary content (%s) cannot be extracted", resp.Header.Get("Content-Type"))
+	}
+	return nil
+}
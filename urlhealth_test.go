@@ -0,0 +1,98 @@
+package tavily
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestExtractHealthCheck(t *testing.T) {
+	site := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/gone":
+			w.WriteHeader(http.StatusGone)
+		case "/missing":
+			w.WriteHeader(http.StatusNotFound)
+		case "/huge":
+			w.Header().Set("Content-Length", strconv.Itoa(DefaultHealthMaxBytes+1))
+			w.Header().Set("Content-Type", "text/html")
+		case "/download":
+			w.Header().Set("Content-Type", "application/octet-stream")
+		case "/no-head":
+			if r.Method == http.MethodHead {
+				w.WriteHeader(http.StatusMethodNotAllowed)
+				return
+			}
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		case "/slow":
+			time.Sleep(200 * time.Millisecond)
+		default:
+			w.Header().Set("Content-Type", "text/html")
+		}
+	}))
+	defer site.Close()
+
+	var sent []string
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req ExtractRequest
+		json.NewDecoder(r.Body).Decode(&req)
+		sent = req.URLs
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"results": [], "failed_results": [{"url": "` + site.URL + `/ok", "error": "timeout"}]}`))
+	}))
+	defer api.Close()
+
+	client := New("tvly-test-key", &Options{BaseURL: api.URL})
+	urls := []string{
+		site.URL + "/ok", site.URL + "/gone", site.URL + "/missing", site.URL + "/huge",
+		site.URL + "/download", site.URL + "/no-head", site.URL + "/slow", "http://127.0.0.1:1/down",
+	}
+	opts := &ExtractOptions{HealthCheck: &HealthCheckOptions{Concurrency: 2, Timeout: 50 * time.Millisecond}}
+
+	resp, err := client.Extract(context.Background(), urls, opts)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+
+	want := []string{site.URL + "/ok", site.URL + "/no-head", site.URL + "/slow"}
+	if !slices.Equal(sent, want) {
+		t.Errorf("sent URLs = %v, want %v", sent, want)
+	}
+	wantReasons := map[string]FailureReason{
+		site.URL + "/gone":        ReasonNotFound,
+		site.URL + "/missing":     ReasonNotFound,
+		site.URL + "/huge":        ReasonTooLarge,
+		site.URL + "/download":    ReasonUnsupported,
+		"http://127.0.0.1:1/down": ReasonUnknown,
+	}
+	if len(resp.Unhealthy) != len(wantReasons) {
+		t.Fatalf("Unhealthy = %+v, want %d entries", resp.Unhealthy, len(wantReasons))
+	}
+	for _, f := range resp.Unhealthy {
+		if f.Reason != wantReasons[f.URL] || f.SkipReason == "" {
+			t.Errorf("Unhealthy %s = %+v, want reason %v", f.URL, f, wantReasons[f.URL])
+		}
+	}
+	if len(resp.FailedResults) != 1 || resp.FailedResults[0].SkipReason != "" {
+		t.Errorf("FailedResults = %+v, want only the API failure", resp.FailedResults)
+	}
+
+	sent = nil
+	resp, err = client.Extract(context.Background(), []string{site.URL + "/gone"}, opts)
+	if err != nil {
+		t.Fatalf("Extract() error = %v", err)
+	}
+	if sent != nil || len(resp.Unhealthy) != 1 {
+		t.Errorf("Extract() of only unhealthy URLs sent %v, Unhealthy = %+v", sent, resp.Unhealthy)
+	}
+
+	healthy, unhealthy := client.CheckURLHealth(context.Background(), []string{site.URL + "/huge"}, &HealthCheckOptions{MaxBytes: -1})
+	if len(healthy) != 1 || len(unhealthy) != 0 {
+		t.Errorf("CheckURLHealth() without a size limit = %v, %+v, want the URL healthy", healthy, unhealthy)
+	}
+}
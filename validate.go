@@ -0,0 +1,107 @@
+package tavily
+
+import (
+	"fmt"
+	"net/url"
+)
+
+// SchemaDrift describes a response field that does not match the documented schema,
+// usually a sign of a silent API change.
+type SchemaDrift struct {
+	// Field is the JSON path of the offending value, e.g. "results[2].score".
+	Field   string
+	Value   any
+	Problem string
+}
+
+func (d SchemaDrift) String() string {
+	return fmt.Sprintf("%s: %s (got %v)", d.Field, d.Problem, d.Value)
+}
+
+// schemaValidator is implemented by responses that can check themselves for drift.
+type schemaValidator interface {
+	Validate() []SchemaDrift
+}
+
+type driftCollector []SchemaDrift
+
+func (c *driftCollector) add(field string, value any, problem string) {
+	*c = append(*c, SchemaDrift{Field: field, Value: value, Problem: problem})
+}
+
+func (c *driftCollector) url(field, value string) {
+	if value == "" {
+		c.add(field, value, "required URL is empty")
+		return
+	}
+	if u, err := url.Parse(value); err != nil || u.Scheme == "" || u.Host == "" {
+		c.add(field, value, "not an absolute URL")
+	}
+}
+
+func (c *driftCollector) responseTime(value float64) {
+	if value < 0 {
+		c.add("response_time", value, "negative duration")
+	}
+}
+
+// Validate checks the response against the documented schema: non-empty URLs,
+// scores within [0, 1] and parseable published dates.
+func (r *SearchResponse) Validate() []SchemaDrift {
+	var c driftCollector
+	c.responseTime(r.ResponseTime)
+	for i, res := range r.Results {
+		field := fmt.Sprintf("results[%d]", i)
+		c.url(field+".url", res.URL)
+		if res.Score < 0 || res.Score > 1 {
+			c.add(field+".score", res.Score, "score outside [0, 1]")
+		}
+		if res.PublishedDate != "" {
+			if _, ok := ParsePublishedDate(res.PublishedDate); !ok {
+				c.add(field+".published_date", res.PublishedDate, "unparseable date")
+			}
+		}
+	}
+	return c
+}
+
+// Validate checks the response against the documented schema.
+func (r *ExtractResponse) Validate() []SchemaDrift {
+	var c driftCollector
+	c.responseTime(r.ResponseTime)
+	for i, res := range r.Results {
+		c.url(fmt.Sprintf("results[%d].url", i), res.URL)
+	}
+	for i, res := range r.FailedResults {
+		if res.URL == "" {
+			c.add(fmt.Sprintf("failed_results[%d].url", i), res.URL, "required URL is empty")
+		}
+	}
+	return c
+}
+
+// Validate checks the response against the documented schema.
+func (r *CrawlResponse) Validate() []SchemaDrift {
+	var c driftCollector
+	c.responseTime(r.ResponseTime)
+	if r.BaseURL == "" {
+		c.add("base_url", r.BaseURL, "required field is empty")
+	}
+	for i, res := range r.Results {
+		c.url(fmt.Sprintf("results[%d].url", i), res.URL)
+	}
+	return c
+}
+
+// Validate checks the response against the documented schema.
+func (r *MapResponse) Validate() []SchemaDrift {
+	var c driftCollector
+	c.responseTime(r.ResponseTime)
+	if r.BaseURL == "" {
+		c.add("base_url", r.BaseURL, "required field is empty")
+	}
+	for i, u := range r.Results {
+		c.url(fmt.Sprintf("results[%d]", i), u)
+	}
+	return c
+}